@@ -28,6 +28,7 @@ import (
 	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/core/vm"
 	"github.com/dexon-foundation/dexon/log"
+	"github.com/dexon-foundation/dexon/params"
 	"github.com/dexon-foundation/dexon/rpc"
 )
 
@@ -125,10 +126,40 @@ func (d *Dexcon) inExtendedRound(header *types.Header, state *state.StateDB) boo
 	return header.Number.Uint64() >= roundEnd
 }
 
-func (d *Dexcon) calculateBlockReward(round uint64) *big.Int {
+// calculateBlockReward returns the reward of a block in round. The reward is
+// calculated in integers once the Dexcon upgrade fork is reached, and with
+// the legacy float32 mining velocity before it.
+func (d *Dexcon) calculateBlockReward(round uint64, upgraded bool) *big.Int {
 	gs := d.govStateFetcer.GetStateForConfigAtRound(round)
 	config := gs.Configuration()
 
+	if !upgraded {
+		return legacyBlockReward(config, gs.TotalStaked())
+	}
+
+	blocksPerRound := new(big.Int).SetUint64(config.RoundLength)
+	roundInterval := new(big.Int).Mul(
+		blocksPerRound, new(big.Int).SetUint64(config.MinBlockInterval))
+
+	// blockReard = miningVelocity * totalStaked * roundInterval / aYear / numBlocksInCurRound
+	// The mining velocity is kept in fixed-point scaled by
+	// MiningVelocityMultiplier, so the whole calculation is done in integers.
+	numerator := new(big.Int).Mul(
+		new(big.Int).Mul(gs.MiningVelocity(), gs.TotalStaked()),
+		roundInterval)
+
+	reward := new(big.Int).Div(numerator,
+		new(big.Int).Mul(
+			vm.MiningVelocityMultiplier(),
+			new(big.Int).Mul(big.NewInt(86400*1000*365), blocksPerRound)))
+
+	return reward
+}
+
+// legacyBlockReward calculates the block reward the way it is done before the
+// Dexcon upgrade fork. The mining velocity goes through float32, so it has to
+// stay as is to reproduce the rewards of existing blocks.
+func legacyBlockReward(config *params.DexconConfig, totalStaked *big.Int) *big.Int {
 	blocksPerRound := config.RoundLength
 	roundInterval := new(big.Float).Mul(
 		big.NewFloat(float64(blocksPerRound)),
@@ -138,7 +169,7 @@ func (d *Dexcon) calculateBlockReward(round uint64) *big.Int {
 	numerator, _ := new(big.Float).Mul(
 		new(big.Float).Mul(
 			big.NewFloat(float64(config.MiningVelocity)),
-			new(big.Float).SetInt(gs.TotalStaked())),
+			new(big.Float).SetInt(totalStaked)),
 		roundInterval).Int(nil)
 
 	reward := new(big.Int).Div(numerator,
@@ -154,12 +185,22 @@ func (d *Dexcon) calculateBlockReward(round uint64) *big.Int {
 func (d *Dexcon) Finalize(chain consensus.ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
 	gs := vm.GovernanceState{state}
 
+	// Migrate the governance state once the upgrade fork is reached.
+	if chain.Config().IsDexconUpgrade(header.Number) && !gs.Upgraded() {
+		gs.Upgrade()
+	}
+
 	height := gs.RoundHeight(new(big.Int).SetUint64(header.Round))
 
 	// The first block of a round is found.
 	if header.Round > 0 && height.Uint64() == 0 {
 		gs.PushRoundHeight(header.Number)
 
+		// Adjust the gas price for the blocks of the round that just ended.
+		if gs.Upgraded() {
+			gs.AdjustMinGasPrice()
+		}
+
 		if header.Round > dexCore.DKGDelayRound {
 			// Check for dead node and disqualify them.
 			// A dead node node is defined as: a notary set node that did not propose
@@ -198,7 +239,7 @@ func (d *Dexcon) Finalize(chain consensus.ChainReader, header *types.Header, sta
 	// If this is not an empty block and we are not in extended round, calculate
 	// the block reward.
 	if header.Coinbase != (common.Address{}) && !d.inExtendedRound(header, state) {
-		reward = d.calculateBlockReward(header.Round)
+		reward = d.calculateBlockReward(header.Round, chain.Config().IsDexconUpgrade(header.Number))
 	}
 
 	header.Reward = reward
@@ -214,6 +255,11 @@ func (d *Dexcon) Finalize(chain consensus.ChainReader, header *types.Header, sta
 	if header.Coinbase != (common.Address{}) {
 		// Record last proposed height.
 		gs.PutLastProposedHeight(header.Coinbase, header.Number)
+
+		// Empty blocks are not proposed, so they say nothing about demand.
+		if gs.Upgraded() {
+			gs.AddRoundGasUsed(new(big.Int).SetUint64(header.GasUsed))
+		}
 	}
 
 	header.Root = state.IntermediateRoot(true)
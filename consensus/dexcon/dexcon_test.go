@@ -24,7 +24,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/dexon-foundation/dexon/common"
+	"github.com/dexon-foundation/dexon/consensus"
 	"github.com/dexon-foundation/dexon/core/state"
+	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/core/vm"
 	"github.com/dexon-foundation/dexon/crypto"
 	"github.com/dexon-foundation/dexon/ethdb"
@@ -43,6 +45,16 @@ func (g *govStateFetcher) DKGSetNodeKeyAddresses(round uint64) (map[common.Addre
 	return make(map[common.Address]struct{}), nil
 }
 
+// chainReader only provides the chain config Finalize needs.
+type chainReader struct {
+	consensus.ChainReader
+	config *params.ChainConfig
+}
+
+func (c *chainReader) Config() *params.ChainConfig {
+	return c.config
+}
+
 type DexconTestSuite struct {
 	suite.Suite
 
@@ -96,7 +108,97 @@ func (d *DexconTestSuite) TestBlockRewardCalculation() {
 
 	// blockReard = miningVelocity * totalStaked * roundInterval / aYear / numBlocksInCurRound
 	// 0.1875 * 1e18 * 3600 * 1000 / (86400 * 1000 * 365 * 3600) = 5945585996.96
-	d.Require().Equal(big.NewInt(5945585996), consensus.calculateBlockReward(0))
+	d.Require().Equal(big.NewInt(5945585996), consensus.calculateBlockReward(0, false))
+	d.Require().Equal(big.NewInt(5945585996), consensus.calculateBlockReward(0, true))
+}
+
+func (d *DexconTestSuite) TestBlockRewardAfterHalving() {
+	consensus := New()
+	consensus.SetGovStateFetcher(&govStateFetcher{d.stateDB})
+
+	totalStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e9))
+	d.s.IncTotalStaked(totalStaked)
+
+	// replay is the reward formula blocks before the upgrade fork were
+	// produced with.
+	replay := func() *big.Int {
+		config := d.s.Configuration()
+		roundInterval := new(big.Float).Mul(
+			big.NewFloat(float64(config.RoundLength)),
+			big.NewFloat(float64(config.MinBlockInterval)))
+		numerator, _ := new(big.Float).Mul(
+			new(big.Float).Mul(
+				big.NewFloat(float64(config.MiningVelocity)),
+				new(big.Float).SetInt(totalStaked)),
+			roundInterval).Int(nil)
+		return new(big.Int).Div(numerator, new(big.Int).Mul(
+			big.NewInt(86400*1000*365), big.NewInt(int64(config.RoundLength))))
+	}
+
+	for i := 0; i < 5; i++ {
+		d.s.MiningHalved()
+	}
+	// 0.1875 halved five times, truncated to eight decimal places.
+	d.Require().Equal(big.NewInt(585937), d.s.MiningVelocity())
+
+	// Rewards before the fork are unchanged.
+	d.Require().Equal(replay(), consensus.calculateBlockReward(0, false))
+
+	// After the fork the fixed-point velocity is used exactly.
+	// 585937 * 1e27 * 3600 * 1000 / (1e8 * 86400 * 1000 * 365 * 3600)
+	expected, ok := new(big.Int).SetString("185799403855910705", 10)
+	d.Require().True(ok)
+	d.Require().Equal(expected, consensus.calculateBlockReward(0, true))
+	d.Require().NotEqual(replay(), consensus.calculateBlockReward(0, true))
+}
+
+func (d *DexconTestSuite) TestFinalizeAdjustsMinGasPrice() {
+	consensus := New()
+	consensus.SetGovStateFetcher(&govStateFetcher{d.stateDB})
+
+	gasLimit := d.s.BlockGasLimit().Uint64()
+	d.s.SetMinGasPrice(big.NewInt(1000))
+	d.s.SetMinGasPriceBounds(big.NewInt(500), big.NewInt(1500))
+	d.s.SetAutoGasPrice(true)
+
+	number := int64(0)
+	finalize := func(config *params.ChainConfig, round uint64) {
+		number++
+		header := &types.Header{
+			Number:   big.NewInt(number),
+			Round:    round,
+			Coinbase: common.Address{1},
+			GasUsed:  gasLimit,
+		}
+		_, err := consensus.Finalize(&chainReader{config: config}, header, d.stateDB, nil, nil, nil)
+		d.Require().NoError(err)
+	}
+
+	// Before the upgrade fork the price stays fixed.
+	config := *params.TestChainConfig
+	config.DexconUpgradeBlock = big.NewInt(100)
+	finalize(&config, 0)
+	d.Require().False(d.s.Upgraded())
+	gasUsed, count := d.s.RoundGasUsed()
+	d.Require().Equal(0, gasUsed.Sign())
+	d.Require().Equal(0, count.Sign())
+
+	// After it full blocks are only recorded during the round.
+	config.DexconUpgradeBlock = big.NewInt(0)
+	for i := 0; i < 3; i++ {
+		finalize(&config, 0)
+		d.Require().Equal(int64(1000), d.s.MinGasPrice().Int64())
+	}
+	gasUsed, count = d.s.RoundGasUsed()
+	d.Require().Equal(new(big.Int).SetUint64(3*gasLimit), gasUsed)
+	d.Require().Equal(int64(3), count.Int64())
+
+	// The next round raises the price by a single step.
+	finalize(&config, 1)
+	d.Require().Equal(int64(1125), d.s.MinGasPrice().Int64())
+	gasUsed, count = d.s.RoundGasUsed()
+	d.Require().Equal(new(big.Int).SetUint64(gasLimit), gasUsed)
+	d.Require().Equal(int64(1), count.Int64())
 }
 
 func TestDexcon(t *testing.T) {
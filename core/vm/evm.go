@@ -141,6 +141,9 @@ type EVM struct {
 	// available gas is calculated in gasCall* according to the 63/64 rule and later
 	// applied in opCall*.
 	callGasTemp uint64
+	// governanceLocked is set while a governance contract method guarded
+	// against reentrancy runs.
+	governanceLocked bool
 }
 
 // NewEVM returns a new EVM. The returned EVM is not thread safe and should
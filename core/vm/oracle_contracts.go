@@ -24,12 +24,14 @@ import (
 	"math"
 	"math/big"
 	"sort"
+	"strconv"
 	"sync/atomic"
 
 	"github.com/dexon-foundation/dexon/accounts/abi"
 	"github.com/dexon-foundation/dexon/common"
 	"github.com/dexon-foundation/dexon/core/types"
 	"github.com/dexon-foundation/dexon/crypto"
+	"github.com/dexon-foundation/dexon/log"
 	"github.com/dexon-foundation/dexon/params"
 	"github.com/dexon-foundation/dexon/rlp"
 
@@ -53,10 +55,67 @@ const (
 	FineTypeInvalidDKG
 	FineTypeForkVote
 	FineTypeForkBlock
+	FineTypeSlashForkBlock
 )
 
+// fineTypesByName maps the names accepted by fineValueByType to fine types,
+// so clients do not depend on the ordering of the enum.
+var fineTypesByName = map[string]FineType{
+	"FailStop":       FineTypeFailStop,
+	"FailStopDKG":    FineTypeFailStopDKG,
+	"InvalidDKG":     FineTypeInvalidDKG,
+	"ForkVote":       FineTypeForkVote,
+	"ForkBlock":      FineTypeForkBlock,
+	"SlashForkBlock": FineTypeSlashForkBlock,
+}
+
 const GovernanceActionGasCost = 200000
 
+var (
+	// revertReasonSelector is the selector of Error(string).
+	revertReasonSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+	revertReasonArguments = func() abi.Arguments {
+		t, err := abi.NewType("string", nil)
+		if err != nil {
+			panic(err)
+		}
+		return abi.Arguments{abi.Argument{Type: t}}
+	}()
+
+	// legacyUnstakedEventId is the topic of Unstaked before the Dexcon
+	// upgrade added its Fee field.
+	legacyUnstakedEventId = crypto.Keccak256Hash([]byte("Unstaked(address,uint256)"))
+
+	// legacyFinePaidEventId is the topic of FinePaid before the Dexcon
+	// upgrade added its Payer field.
+	legacyFinePaidEventId = crypto.Keccak256Hash([]byte("FinePaid(address,uint256)"))
+)
+
+// minGasPriceChangeDenominator bounds the change of minGasPrice per round
+// made by AdjustMinGasPrice.
+const minGasPriceChangeDenominator = 8
+
+// maxNodesPageSize bounds the number of nodes returned by nodesPaginated.
+const maxNodesPageSize = 50
+
+// maxDKGResetCountsSpan bounds the number of rounds queried by dkgResetCounts.
+const maxDKGResetCountsSpan = 100
+
+// maxRoundHeightsSpan bounds the number of rounds queried by roundHeights.
+const maxRoundHeightsSpan = 100
+
+// maxReportBatchSize bounds the number of entries accepted by reportBatch.
+const maxReportBatchSize = 32
+
+// reportBatchEntryGasCost is charged by reportBatch for every entry, skipped
+// or not, to cover verifying it.
+const reportBatchEntryGasCost = 20000
+
+// maxArrayLength bounds iteration over storage arrays. A longer stored length
+// can only come from corrupted state.
+const maxArrayLength = 1 << 16
+
 // Storage position enums.
 const (
 	roundHeightLoc = iota
@@ -97,6 +156,33 @@ const (
 	minBlockIntervalLoc
 	fineValuesLoc
 	finedRecordsLoc
+	pausedLoc
+	totalFinedLoc
+	awardPoolLoc
+	reportCooldownLoc
+	lastFinedAtLoc
+	treasuryLoc
+	qualifiedNodesCountLoc
+	pendingOwnerLoc
+	dkgResetGracePercentLoc
+	unstakeFeeLoc
+	totalUnstakedLoc
+	dkgRewardLoc
+	dkgRewardedLoc
+	dkgComplaintGasLoc
+	autoGasPriceLoc
+	minGasPriceLowerBoundLoc
+	minGasPriceUpperBoundLoc
+	participationCountLoc
+	lastParticipatedRoundLoc
+	crsProposerRewardLoc
+	maxDKGComplaintsLoc
+	upgradedLoc
+	dkgRewardRoundLoc
+	fineCapMultipleLoc
+	roundConsistencyCheckLoc
+	roundGasUsedLoc
+	roundBlockCountLoc
 )
 
 func publicKeyToNodeKeyAddress(pkBytes []byte) (common.Address, error) {
@@ -144,6 +230,16 @@ func (s *GovernanceState) setStateBigInt(loc *big.Int, val *big.Int) {
 	s.setState(common.BigToHash(loc), common.BigToHash(val))
 }
 
+// boundedLength returns length as an iteration count capped at
+// maxArrayLength, so a corrupted length slot can not stall the node.
+func (s *GovernanceState) boundedLength(length *big.Int) int64 {
+	if length.Cmp(big.NewInt(maxArrayLength)) > 0 {
+		log.Error("Governance array length out of bounds", "length", length)
+		return maxArrayLength
+	}
+	return length.Int64()
+}
+
 func (s *GovernanceState) getSlotLoc(loc *big.Int) *big.Int {
 	return new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(loc).Bytes()))
 }
@@ -161,7 +257,8 @@ func (s *GovernanceState) readBytes(loc *big.Int) []byte {
 	// return the high 31 bytes.
 	if new(big.Int).Mod(lengthByte, big.NewInt(2)).Cmp(big.NewInt(0)) == 0 {
 		length := new(big.Int).Div(lengthByte, big.NewInt(2)).Uint64()
-		return rawLength.Bytes()[:length]
+		// Read from the raw slot; big.Int.Bytes strips leading zero bytes.
+		return s.getState(common.BigToHash(loc)).Bytes()[:length]
 	}
 
 	// Actual length = (rawLength - 1) / 2
@@ -259,7 +356,7 @@ func (s *GovernanceState) read1DByteArray(loc *big.Int) [][]byte {
 	dataLoc := s.getSlotLoc(loc)
 
 	data := [][]byte{}
-	for i := int64(0); i < int64(arrayLength.Uint64()); i++ {
+	for i := int64(0); i < s.boundedLength(arrayLength); i++ {
 		elementLoc := new(big.Int).Add(dataLoc, big.NewInt(i))
 		data = append(data, s.readBytes(elementLoc))
 	}
@@ -281,7 +378,7 @@ func (s *GovernanceState) erase1DByteArray(loc *big.Int) {
 	arrayLength := s.getStateBigInt(loc)
 	dataLoc := s.getSlotLoc(loc)
 
-	for i := int64(0); i < int64(arrayLength.Uint64()); i++ {
+	for i := int64(0); i < s.boundedLength(arrayLength); i++ {
 		elementLoc := new(big.Int).Add(dataLoc, big.NewInt(i))
 		s.eraseBytes(elementLoc)
 	}
@@ -289,6 +386,9 @@ func (s *GovernanceState) erase1DByteArray(loc *big.Int) {
 }
 
 // uint256[] public roundHeight;
+func (s *GovernanceState) LenRoundHeight() *big.Int {
+	return s.getStateBigInt(big.NewInt(roundHeightLoc))
+}
 func (s *GovernanceState) RoundHeight(round *big.Int) *big.Int {
 	baseLoc := s.getSlotLoc(big.NewInt(roundHeightLoc))
 	loc := new(big.Int).Add(baseLoc, round)
@@ -305,6 +405,10 @@ func (s *GovernanceState) PushRoundHeight(height *big.Int) {
 	s.setStateBigInt(loc, height)
 }
 
+// errStateUnderflow is returned when a decrement would take a counter below
+// zero, which setStateBigInt would otherwise store as a two's-complement word.
+var errStateUnderflow = errors.New("governance state underflow")
+
 // uint256 public totalSupply;
 func (s *GovernanceState) TotalSupply() *big.Int {
 	return s.getStateBigInt(big.NewInt(totalSupplyLoc))
@@ -312,8 +416,13 @@ func (s *GovernanceState) TotalSupply() *big.Int {
 func (s *GovernanceState) IncTotalSupply(amount *big.Int) {
 	s.setStateBigInt(big.NewInt(totalSupplyLoc), new(big.Int).Add(s.TotalSupply(), amount))
 }
-func (s *GovernanceState) DecTotalSupply(amount *big.Int) {
-	s.setStateBigInt(big.NewInt(totalSupplyLoc), new(big.Int).Sub(s.TotalSupply(), amount))
+func (s *GovernanceState) DecTotalSupply(amount *big.Int) error {
+	value := new(big.Int).Sub(s.TotalSupply(), amount)
+	if value.Cmp(big.NewInt(0)) < 0 {
+		return errStateUnderflow
+	}
+	s.setStateBigInt(big.NewInt(totalSupplyLoc), value)
+	return nil
 }
 
 // uint256 public totalStaked;
@@ -323,8 +432,13 @@ func (s *GovernanceState) TotalStaked() *big.Int {
 func (s *GovernanceState) IncTotalStaked(amount *big.Int) {
 	s.setStateBigInt(big.NewInt(totalStakedLoc), new(big.Int).Add(s.TotalStaked(), amount))
 }
-func (s *GovernanceState) DecTotalStaked(amount *big.Int) {
-	s.setStateBigInt(big.NewInt(totalStakedLoc), new(big.Int).Sub(s.TotalStaked(), amount))
+func (s *GovernanceState) DecTotalStaked(amount *big.Int) error {
+	value := new(big.Int).Sub(s.TotalStaked(), amount)
+	if value.Cmp(big.NewInt(0)) < 0 {
+		return errStateUnderflow
+	}
+	s.setStateBigInt(big.NewInt(totalStakedLoc), value)
+	return nil
 }
 
 // struct Node {
@@ -356,6 +470,16 @@ type nodeInfo struct {
 	UnstakedAt *big.Int
 }
 
+// EffectiveStake returns the stake of the node minus its unpaid fine,
+// clamped at zero.
+func (n *nodeInfo) EffectiveStake() *big.Int {
+	stake := new(big.Int).Sub(n.Staked, n.Fined)
+	if stake.Cmp(big.NewInt(0)) < 0 {
+		return big.NewInt(0)
+	}
+	return stake
+}
+
 const nodeStructSize = 10
 
 func (s *GovernanceState) LenNodes() *big.Int {
@@ -465,29 +589,45 @@ func (s *GovernanceState) UpdateNode(index *big.Int, n *nodeInfo) {
 	// Update set size.
 	s.CalNotarySetSize()
 }
+
+// EraseNode clears all storage of the node struct at index, including the
+// data slots of its dynamic fields.
+func (s *GovernanceState) EraseNode(index *big.Int) {
+	arrayBaseLoc := s.getSlotLoc(big.NewInt(nodesLoc))
+	elementBaseLoc := new(big.Int).Add(arrayBaseLoc,
+		new(big.Int).Mul(index, big.NewInt(nodeStructSize)))
+
+	// PublicKey, Name, Email, Location and Url.
+	for _, i := range []int64{1, 4, 5, 6, 7} {
+		s.eraseBytes(new(big.Int).Add(elementBaseLoc, big.NewInt(i)))
+	}
+
+	// Owner, Staked, Fined, Unstaked and UnstakedAt.
+	for _, i := range []int64{0, 2, 3, 8, 9} {
+		s.setStateBigInt(new(big.Int).Add(elementBaseLoc, big.NewInt(i)), big.NewInt(0))
+	}
+}
 func (s *GovernanceState) PopLastNode() {
 	// Decrease length by 1.
 	arrayLength := s.LenNodes()
 	newArrayLength := new(big.Int).Sub(arrayLength, big.NewInt(1))
 	s.setStateBigInt(big.NewInt(nodesLoc), newArrayLength)
 
-	s.UpdateNode(newArrayLength, &nodeInfo{
-		Staked:     big.NewInt(0),
-		Fined:      big.NewInt(0),
-		Unstaked:   big.NewInt(0),
-		UnstakedAt: big.NewInt(0),
-	})
+	s.EraseNode(newArrayLength)
+
+	// Update set size.
+	s.CalNotarySetSize()
 }
 func (s *GovernanceState) Nodes() []*nodeInfo {
 	var nodes []*nodeInfo
-	for i := int64(0); i < int64(s.LenNodes().Uint64()); i++ {
+	for i := int64(0); i < s.boundedLength(s.LenNodes()); i++ {
 		nodes = append(nodes, s.Node(big.NewInt(i)))
 	}
 	return nodes
 }
 func (s *GovernanceState) QualifiedNodes() []*nodeInfo {
 	var nodes []*nodeInfo
-	for i := int64(0); i < int64(s.LenNodes().Uint64()); i++ {
+	for i := int64(0); i < s.boundedLength(s.LenNodes()); i++ {
 		node := s.Node(big.NewInt(i))
 		// Node with unpaid fine is consider unqualified.
 		if node.Fined.Cmp(big.NewInt(0)) > 0 {
@@ -609,13 +749,13 @@ func (s *GovernanceState) DKGMasterPublicKey(offset *big.Int) []byte {
 	elementLoc := new(big.Int).Add(dataLoc, offset)
 	return s.readBytes(elementLoc)
 }
-func (s *GovernanceState) DKGMasterPublicKeyItem(offset *big.Int) *dkgTypes.MasterPublicKey {
+func (s *GovernanceState) DKGMasterPublicKeyItem(offset *big.Int) (*dkgTypes.MasterPublicKey, error) {
 	element := s.DKGMasterPublicKey(offset)
 	x := new(dkgTypes.MasterPublicKey)
 	if err := rlp.DecodeBytes(element, x); err != nil {
-		panic(err)
+		return nil, err
 	}
-	return x
+	return x, nil
 }
 func (s *GovernanceState) DKGMasterPublicKeys() [][]byte {
 	return s.read1DByteArray(big.NewInt(dkgMasterPublicKeysLoc))
@@ -629,7 +769,8 @@ func (s *GovernanceState) DKGMasterPublicKeyItems() []*dkgTypes.MasterPublicKey
 	for _, mpk := range s.DKGMasterPublicKeys() {
 		x := new(dkgTypes.MasterPublicKey)
 		if err := rlp.DecodeBytes(mpk, x); err != nil {
-			panic(err)
+			log.Debug("Skip undecodable DKG master public key", "err", err)
+			continue
 		}
 		dkgMasterPKs = append(dkgMasterPKs, x)
 	}
@@ -678,7 +819,8 @@ func (s *GovernanceState) DKGComplaintItems() []*dkgTypes.Complaint {
 	for _, pk := range s.DKGComplaints() {
 		x := new(dkgTypes.Complaint)
 		if err := rlp.DecodeBytes(pk, x); err != nil {
-			panic(err)
+			log.Debug("Skip undecodable DKG complaint", "err", err)
+			continue
 		}
 		dkgComplaints = append(dkgComplaints, x)
 	}
@@ -806,6 +948,15 @@ func (s *GovernanceState) SetOwner(newOwner common.Address) {
 	s.setState(common.BigToHash(big.NewInt(ownerLoc)), newOwner.Hash())
 }
 
+// address public pendingOwner;
+func (s *GovernanceState) PendingOwner() common.Address {
+	val := s.getState(common.BigToHash(big.NewInt(pendingOwnerLoc)))
+	return common.BytesToAddress(val.Bytes())
+}
+func (s *GovernanceState) SetPendingOwner(pendingOwner common.Address) {
+	s.setState(common.BigToHash(big.NewInt(pendingOwnerLoc)), pendingOwner.Hash())
+}
+
 // uint256 public minStake;
 func (s *GovernanceState) MinStake() *big.Int {
 	return s.getStateBigInt(big.NewInt(minStakeLoc))
@@ -852,6 +1003,9 @@ func (s *GovernanceState) MiningHalved() {
 func (s *GovernanceState) MinGasPrice() *big.Int {
 	return s.getStateBigInt(big.NewInt(minGasPriceLoc))
 }
+func (s *GovernanceState) SetMinGasPrice(price *big.Int) {
+	s.setStateBigInt(big.NewInt(minGasPriceLoc), price)
+}
 
 // uint256 public blockGasLimit;
 func (s *GovernanceState) BlockGasLimit() *big.Int {
@@ -876,7 +1030,12 @@ func (s *GovernanceState) NotarySetSize() *big.Int {
 	return s.getStateBigInt(big.NewInt(notarySetSizeLoc))
 }
 func (s *GovernanceState) CalNotarySetSize() {
-	nodeSetSize := float64(len(s.QualifiedNodes()))
+	qualified := len(s.QualifiedNodes())
+	if s.qualifiedNodesCountTracked() {
+		s.setQualifiedNodesCount(uint64(qualified))
+	}
+
+	nodeSetSize := float64(qualified)
 	setSize := math.Ceil((nodeSetSize*0.6-1)/3)*3 + 1
 
 	if nodeSetSize >= 80 {
@@ -887,6 +1046,32 @@ func (s *GovernanceState) CalNotarySetSize() {
 	s.setStateBigInt(big.NewInt(notarySetSizeLoc), big.NewInt(int64(setSize)))
 }
 
+// uint256 public qualifiedNodesCount;
+//
+// The count is stored with an offset of one. A zero slot means the count is
+// not tracked yet, which is the case before the Dexcon upgrade fork, and falls
+// back to iteration.
+func (s *GovernanceState) QualifiedNodesCount() *big.Int {
+	if !s.qualifiedNodesCountTracked() {
+		return big.NewInt(int64(len(s.QualifiedNodes())))
+	}
+	value := s.getStateBigInt(big.NewInt(qualifiedNodesCountLoc))
+	return new(big.Int).Sub(value, big.NewInt(1))
+}
+func (s *GovernanceState) qualifiedNodesCountTracked() bool {
+	return s.getStateBigInt(big.NewInt(qualifiedNodesCountLoc)).Cmp(big.NewInt(0)) > 0
+}
+
+// trackQualifiedNodesCount starts maintaining the qualified nodes counter.
+// It is called by Upgrade and updated by CalNotarySetSize from then on.
+func (s *GovernanceState) trackQualifiedNodesCount() {
+	s.setQualifiedNodesCount(uint64(len(s.QualifiedNodes())))
+}
+func (s *GovernanceState) setQualifiedNodesCount(count uint64) {
+	value := new(big.Int).SetUint64(count)
+	s.setStateBigInt(big.NewInt(qualifiedNodesCountLoc), value.Add(value, big.NewInt(1)))
+}
+
 // uint256 public notaryParamAlpha;
 func (s *GovernanceState) NotaryParamAlpha() *big.Int {
 	return s.getStateBigInt(big.NewInt(notaryParamAlphaLoc))
@@ -901,6 +1086,9 @@ func (s *GovernanceState) NotaryParamBeta() *big.Int {
 func (s *GovernanceState) RoundLength() *big.Int {
 	return s.getStateBigInt(big.NewInt(roundLengthLoc))
 }
+func (s *GovernanceState) SetRoundLength(length *big.Int) {
+	s.setStateBigInt(big.NewInt(roundLengthLoc), length)
+}
 
 // uint256 public minBlockInterval;
 func (s *GovernanceState) MinBlockInterval() *big.Int {
@@ -908,15 +1096,18 @@ func (s *GovernanceState) MinBlockInterval() *big.Int {
 }
 
 // uint256[] public fineValues;
+func (s *GovernanceState) LenFineValues() *big.Int {
+	return s.getStateBigInt(big.NewInt(fineValuesLoc))
+}
 func (s *GovernanceState) FineValue(index *big.Int) *big.Int {
 	arrayBaseLoc := s.getSlotLoc(big.NewInt(fineValuesLoc))
 	return s.getStateBigInt(new(big.Int).Add(arrayBaseLoc, index))
 }
 func (s *GovernanceState) FineValues() []*big.Int {
-	len := s.getStateBigInt(big.NewInt(fineValuesLoc))
-	result := make([]*big.Int, len.Uint64())
-	for i := 0; i < int(len.Uint64()); i++ {
-		result[i] = s.FineValue(big.NewInt(int64(i)))
+	len := s.boundedLength(s.LenFineValues())
+	result := make([]*big.Int, len)
+	for i := int64(0); i < len; i++ {
+		result[i] = s.FineValue(big.NewInt(i))
 	}
 	return result
 }
@@ -943,8 +1134,368 @@ func (s *GovernanceState) SetFineRecords(recordHash Bytes32, status bool) {
 	s.setStateBigInt(loc, big.NewInt(value))
 }
 
+// bool public paused;
+func (s *GovernanceState) Paused() bool {
+	return s.getStateBigInt(big.NewInt(pausedLoc)).Cmp(big.NewInt(0)) != 0
+}
+func (s *GovernanceState) SetPaused(paused bool) {
+	value := int64(0)
+	if paused {
+		value = int64(1)
+	}
+	s.setStateBigInt(big.NewInt(pausedLoc), big.NewInt(value))
+}
+
+// bool public roundConsistencyCheck;
+//
+// Whether proposeCRS and resetDKG verify that the CRS and DKG rounds stay in
+// step, see GovernanceContract.assertRoundConsistency. Upgrade enables it.
+func (s *GovernanceState) RoundConsistencyCheck() bool {
+	return s.getStateBigInt(big.NewInt(roundConsistencyCheckLoc)).Cmp(big.NewInt(0)) != 0
+}
+func (s *GovernanceState) SetRoundConsistencyCheck(enabled bool) {
+	value := int64(0)
+	if enabled {
+		value = int64(1)
+	}
+	s.setStateBigInt(big.NewInt(roundConsistencyCheckLoc), big.NewInt(value))
+}
+
+// uint256 public totalFined;
+//
+// The counter is only maintained once the state is upgraded. Upgrade starts
+// it from the fines outstanding at the upgrade block, so fines issued before
+// it can still be paid off without underflowing the counter.
+func (s *GovernanceState) TotalFined() *big.Int {
+	return s.getStateBigInt(big.NewInt(totalFinedLoc))
+}
+func (s *GovernanceState) IncTotalFined(amount *big.Int) {
+	if !s.Upgraded() {
+		return
+	}
+	s.setStateBigInt(big.NewInt(totalFinedLoc), new(big.Int).Add(s.TotalFined(), amount))
+}
+func (s *GovernanceState) DecTotalFined(amount *big.Int) error {
+	if !s.Upgraded() {
+		return nil
+	}
+	value := new(big.Int).Sub(s.TotalFined(), amount)
+	if value.Cmp(big.NewInt(0)) < 0 {
+		return errStateUnderflow
+	}
+	s.setStateBigInt(big.NewInt(totalFinedLoc), value)
+	return nil
+}
+
+// uint256 public awardPool;
+func (s *GovernanceState) AwardPool() *big.Int {
+	return s.getStateBigInt(big.NewInt(awardPoolLoc))
+}
+func (s *GovernanceState) IncAwardPool(amount *big.Int) {
+	s.setStateBigInt(big.NewInt(awardPoolLoc), new(big.Int).Add(s.AwardPool(), amount))
+}
+func (s *GovernanceState) DecAwardPool(amount *big.Int) {
+	s.setStateBigInt(big.NewInt(awardPoolLoc), new(big.Int).Sub(s.AwardPool(), amount))
+}
+
+// DrainAwardPool takes up to amount out of the award pool and removes it
+// from the governance contract balance. The caller is responsible for
+// crediting the returned amount to its recipients.
+func (s *GovernanceState) DrainAwardPool(amount *big.Int) *big.Int {
+	pool := s.AwardPool()
+	if amount.Cmp(pool) > 0 {
+		amount = pool
+	}
+	amount = new(big.Int).Set(amount)
+	s.setStateBigInt(big.NewInt(awardPoolLoc), new(big.Int).Sub(pool, amount))
+	s.StateDB.SubBalance(GovernanceContractAddress, amount)
+	return amount
+}
+
+// uint256 public dkgResetGracePercent;
+//
+// Percentage of a round that must pass before its DKG can be reset. Zero
+// means the default of 85.
+func (s *GovernanceState) DKGResetGracePercent() *big.Int {
+	percent := s.getStateBigInt(big.NewInt(dkgResetGracePercentLoc))
+	if percent.Cmp(big.NewInt(0)) == 0 {
+		return big.NewInt(85)
+	}
+	return percent
+}
+func (s *GovernanceState) SetDKGResetGracePercent(percent *big.Int) {
+	s.setStateBigInt(big.NewInt(dkgResetGracePercentLoc), percent)
+}
+
+// uint256 public unstakeFee;
+//
+// Fee in basis points deducted from unstaked amounts into the award pool.
+func (s *GovernanceState) UnstakeFee() *big.Int {
+	return s.getStateBigInt(big.NewInt(unstakeFeeLoc))
+}
+func (s *GovernanceState) SetUnstakeFee(fee *big.Int) {
+	s.setStateBigInt(big.NewInt(unstakeFeeLoc), fee)
+}
+
+// uint256 public totalUnstaked;
+//
+// Sum of unstaked amounts still held by the contract pending withdrawal.
+func (s *GovernanceState) TotalUnstaked() *big.Int {
+	return s.getStateBigInt(big.NewInt(totalUnstakedLoc))
+}
+func (s *GovernanceState) IncTotalUnstaked(amount *big.Int) {
+	s.setStateBigInt(big.NewInt(totalUnstakedLoc), new(big.Int).Add(s.TotalUnstaked(), amount))
+}
+func (s *GovernanceState) DecTotalUnstaked(amount *big.Int) error {
+	value := new(big.Int).Sub(s.TotalUnstaked(), amount)
+	if value.Cmp(big.NewInt(0)) < 0 {
+		return errStateUnderflow
+	}
+	s.setStateBigInt(big.NewInt(totalUnstakedLoc), value)
+	return nil
+}
+
+// uint256 public dkgReward;
+//
+// Reward paid from the award pool to each node completing a DKG.
+func (s *GovernanceState) DKGReward() *big.Int {
+	return s.getStateBigInt(big.NewInt(dkgRewardLoc))
+}
+func (s *GovernanceState) SetDKGReward(reward *big.Int) {
+	s.setStateBigInt(big.NewInt(dkgRewardLoc), reward)
+}
+
+// uint256 public dkgRewardRound;
+//
+// DKG round in which the DKG reward was last set. Earlier rounds can not be
+// claimed.
+func (s *GovernanceState) DKGRewardRound() *big.Int {
+	return s.getStateBigInt(big.NewInt(dkgRewardRoundLoc))
+}
+func (s *GovernanceState) SetDKGRewardRound(round *big.Int) {
+	s.setStateBigInt(big.NewInt(dkgRewardRoundLoc), round)
+}
+
+// uint256 public crsProposerReward;
+//
+// Reward paid from the award pool to the proposer of each round's CRS.
+func (s *GovernanceState) CRSProposerReward() *big.Int {
+	return s.getStateBigInt(big.NewInt(crsProposerRewardLoc))
+}
+func (s *GovernanceState) SetCRSProposerReward(reward *big.Int) {
+	s.setStateBigInt(big.NewInt(crsProposerRewardLoc), reward)
+}
+
+// uint256 public dkgComplaintGas;
+//
+// Gas charged by addDKGComplaint to prevent spamming. Zero means the default
+// of GovernanceActionGasCost.
+func (s *GovernanceState) DKGComplaintGas() *big.Int {
+	gas := s.getStateBigInt(big.NewInt(dkgComplaintGasLoc))
+	if gas.Cmp(big.NewInt(0)) == 0 {
+		return big.NewInt(GovernanceActionGasCost)
+	}
+	return gas
+}
+func (s *GovernanceState) SetDKGComplaintGas(gas *big.Int) {
+	s.setStateBigInt(big.NewInt(dkgComplaintGasLoc), gas)
+}
+
+// bool public autoGasPrice;
+//
+// Whether minGasPrice follows block fullness, see AdjustMinGasPrice.
+func (s *GovernanceState) AutoGasPrice() bool {
+	return s.getStateBigInt(big.NewInt(autoGasPriceLoc)).Cmp(big.NewInt(0)) != 0
+}
+func (s *GovernanceState) SetAutoGasPrice(enabled bool) {
+	value := int64(0)
+	if enabled {
+		value = int64(1)
+	}
+	s.setStateBigInt(big.NewInt(autoGasPriceLoc), big.NewInt(value))
+}
+
+// uint256 public minGasPriceLowerBound;
+// uint256 public minGasPriceUpperBound;
+//
+// Range minGasPrice is kept in when adjusted automatically.
+func (s *GovernanceState) MinGasPriceBounds() (*big.Int, *big.Int) {
+	return s.getStateBigInt(big.NewInt(minGasPriceLowerBoundLoc)),
+		s.getStateBigInt(big.NewInt(minGasPriceUpperBoundLoc))
+}
+func (s *GovernanceState) SetMinGasPriceBounds(lower, upper *big.Int) {
+	s.setStateBigInt(big.NewInt(minGasPriceLowerBoundLoc), lower)
+	s.setStateBigInt(big.NewInt(minGasPriceUpperBoundLoc), upper)
+}
+
+// uint256 public roundGasUsed;
+// uint256 public roundBlockCount;
+//
+// Gas used by, and number of, the blocks of the current round recorded for
+// AdjustMinGasPrice.
+func (s *GovernanceState) RoundGasUsed() (*big.Int, *big.Int) {
+	return s.getStateBigInt(big.NewInt(roundGasUsedLoc)),
+		s.getStateBigInt(big.NewInt(roundBlockCountLoc))
+}
+
+// AddRoundGasUsed records the gas used by a block of the current round. It
+// does nothing unless AutoGasPrice is enabled.
+func (s *GovernanceState) AddRoundGasUsed(blockGasUsed *big.Int) {
+	if !s.AutoGasPrice() {
+		return
+	}
+	gasUsed, count := s.RoundGasUsed()
+	s.setStateBigInt(big.NewInt(roundGasUsedLoc), new(big.Int).Add(gasUsed, blockGasUsed))
+	s.setStateBigInt(big.NewInt(roundBlockCountLoc), new(big.Int).Add(count, big.NewInt(1)))
+}
+
+// AdjustMinGasPrice is called when a round ends. It moves minGasPrice towards
+// the price at which the blocks recorded by AddRoundGasUsed are half full on
+// average, by at most 1/minGasPriceChangeDenominator, and keeps it within
+// MinGasPriceBounds. The recorded gas usage is then cleared for the next
+// round. Like other configuration, the new price is used from the round
+// ConfigRoundShift rounds after the one starting with the adjusting block.
+func (s *GovernanceState) AdjustMinGasPrice() {
+	gasUsed, count := s.RoundGasUsed()
+	if count.Cmp(big.NewInt(0)) == 0 {
+		return
+	}
+	s.setStateBigInt(big.NewInt(roundGasUsedLoc), big.NewInt(0))
+	s.setStateBigInt(big.NewInt(roundBlockCountLoc), big.NewInt(0))
+
+	if !s.AutoGasPrice() {
+		return
+	}
+	target := new(big.Int).Div(s.BlockGasLimit(), big.NewInt(2))
+	if target.Cmp(big.NewInt(0)) <= 0 {
+		return
+	}
+
+	// Compare the total against the target of all blocks to avoid rounding
+	// the average.
+	target.Mul(target, count)
+	price := s.MinGasPrice()
+	delta := new(big.Int).Sub(gasUsed, target)
+	delta.Mul(delta, price)
+	delta.Quo(delta, target)
+	delta.Quo(delta, big.NewInt(minGasPriceChangeDenominator))
+	// Make sure a low price can still rise.
+	if delta.Cmp(big.NewInt(0)) == 0 && gasUsed.Cmp(target) > 0 {
+		delta = big.NewInt(1)
+	}
+	price = new(big.Int).Add(price, delta)
+
+	lower, upper := s.MinGasPriceBounds()
+	if price.Cmp(lower) < 0 {
+		price = lower
+	}
+	if price.Cmp(upper) > 0 {
+		price = upper
+	}
+	s.SetMinGasPrice(price)
+}
+
+// uint256 public maxDKGComplaints;
+//
+// Limit of complaints stored for a DKG. Zero means the default derived from
+// the DKG set size, see GovernanceContract.maxDKGComplaints.
+func (s *GovernanceState) MaxDKGComplaints() *big.Int {
+	return s.getStateBigInt(big.NewInt(maxDKGComplaintsLoc))
+}
+func (s *GovernanceState) SetMaxDKGComplaints(limit *big.Int) {
+	s.setStateBigInt(big.NewInt(maxDKGComplaintsLoc), limit)
+}
+
+// mapping(uint256 => mapping(address => bool)) public dkgRewarded;
+func (s *GovernanceState) DKGRewarded(round *big.Int, addr common.Address) bool {
+	roundLoc := s.getMapLoc(big.NewInt(dkgRewardedLoc), common.BigToHash(round).Bytes())
+	loc := s.getMapLoc(roundLoc, addr.Bytes())
+	return s.getStateBigInt(loc).Cmp(big.NewInt(0)) != 0
+}
+func (s *GovernanceState) PutDKGRewarded(round *big.Int, addr common.Address) {
+	roundLoc := s.getMapLoc(big.NewInt(dkgRewardedLoc), common.BigToHash(round).Bytes())
+	loc := s.getMapLoc(roundLoc, addr.Bytes())
+	s.setStateBigInt(loc, big.NewInt(1))
+}
+
+// mapping(address => uint256) public participationCount;
+//
+// Number of rounds whose DKG a node finalized, keyed by node key address.
+func (s *GovernanceState) ParticipationCount(addr common.Address) *big.Int {
+	loc := s.getMapLoc(big.NewInt(participationCountLoc), addr.Bytes())
+	return s.getStateBigInt(loc)
+}
+
+// mapping(address => uint256) lastParticipatedRound;
+//
+// The slot keeps the last counted round plus one, so that an address without
+// counted rounds can be told apart from one that counted round 0.
+// LastParticipatedRound returns -1 for the former.
+func (s *GovernanceState) LastParticipatedRound(addr common.Address) *big.Int {
+	loc := s.getMapLoc(big.NewInt(lastParticipatedRoundLoc), addr.Bytes())
+	return new(big.Int).Sub(s.getStateBigInt(loc), big.NewInt(1))
+}
+
+// IncParticipationCount counts round for addr unless it is already counted.
+// The last counted round is kept apart from the DKG state so that it
+// survives clearDKG, and a DKG reset does not count the round twice.
+func (s *GovernanceState) IncParticipationCount(addr common.Address, round *big.Int) {
+	if s.LastParticipatedRound(addr).Cmp(round) >= 0 {
+		return
+	}
+	s.setStateBigInt(s.getMapLoc(big.NewInt(lastParticipatedRoundLoc), addr.Bytes()),
+		new(big.Int).Add(round, big.NewInt(1)))
+	s.setStateBigInt(s.getMapLoc(big.NewInt(participationCountLoc), addr.Bytes()),
+		new(big.Int).Add(s.ParticipationCount(addr), big.NewInt(1)))
+}
+
+// uint256 public fineCapMultiple;
+//
+// Cap of the outstanding fine of a node in multiples of MinStake. Zero caps
+// it at the node's stake, see FineCap.
+func (s *GovernanceState) FineCapMultiple() *big.Int {
+	return s.getStateBigInt(big.NewInt(fineCapMultipleLoc))
+}
+func (s *GovernanceState) SetFineCapMultiple(multiple *big.Int) {
+	s.setStateBigInt(big.NewInt(fineCapMultipleLoc), multiple)
+}
+
+// uint256 public reportCooldown;
+func (s *GovernanceState) ReportCooldown() *big.Int {
+	return s.getStateBigInt(big.NewInt(reportCooldownLoc))
+}
+func (s *GovernanceState) SetReportCooldown(cooldown *big.Int) {
+	s.setStateBigInt(big.NewInt(reportCooldownLoc), cooldown)
+}
+
+// mapping(address => mapping(uint256 => uint256)) public lastFinedAt;
+func (s *GovernanceState) LastFinedAt(addr common.Address, fineType *big.Int) *big.Int {
+	addrLoc := s.getMapLoc(big.NewInt(lastFinedAtLoc), addr.Bytes())
+	loc := s.getMapLoc(addrLoc, common.BigToHash(fineType).Bytes())
+	return s.getStateBigInt(loc)
+}
+func (s *GovernanceState) PutLastFinedAt(addr common.Address, fineType *big.Int, time *big.Int) {
+	addrLoc := s.getMapLoc(big.NewInt(lastFinedAtLoc), addr.Bytes())
+	loc := s.getMapLoc(addrLoc, common.BigToHash(fineType).Bytes())
+	s.setStateBigInt(loc, time)
+}
+
+// address public treasury;
+func (s *GovernanceState) Treasury() common.Address {
+	val := s.getState(common.BigToHash(big.NewInt(treasuryLoc)))
+	return common.BytesToAddress(val.Bytes())
+}
+func (s *GovernanceState) SetTreasury(treasury common.Address) {
+	s.setState(common.BigToHash(big.NewInt(treasuryLoc)), treasury.Hash())
+}
+
 // Initialize initializes governance contract state.
 func (s *GovernanceState) Initialize(config *params.DexconConfig, totalSupply *big.Int) {
+	// The round 0 height pushed below marks the state as initialized.
+	if s.Initialized() {
+		panic("governance state already initialized")
+	}
+
 	if config.NextHalvingSupply.Cmp(totalSupply) <= 0 {
 		panic(fmt.Sprintf("invalid genesis found, totalSupply: %s, nextHavlingSupply: %s",
 			totalSupply, config.NextHalvingSupply))
@@ -970,6 +1521,39 @@ func (s *GovernanceState) Initialize(config *params.DexconConfig, totalSupply *b
 	s.SetDKGRound(big.NewInt(int64(dexCore.DKGDelayRound)))
 }
 
+// Initialized returns whether Initialize has been run on the state.
+func (s *GovernanceState) Initialized() bool {
+	return s.LenRoundHeight().Cmp(big.NewInt(0)) > 0
+}
+
+// Upgrade migrates the governance state at the Dexcon upgrade fork block. It
+// starts maintaining the counters which states from before the fork lack.
+func (s *GovernanceState) Upgrade() {
+	if s.Upgraded() {
+		panic("governance state already upgraded")
+	}
+	s.trackQualifiedNodesCount()
+
+	// Funds unstaked and fines issued before the counters existed are not
+	// part of them.
+	totalUnstaked := big.NewInt(0)
+	totalFined := big.NewInt(0)
+	for _, node := range s.Nodes() {
+		totalUnstaked.Add(totalUnstaked, node.Unstaked)
+		totalFined.Add(totalFined, node.Fined)
+	}
+	s.setStateBigInt(big.NewInt(totalUnstakedLoc), totalUnstaked)
+	s.setStateBigInt(big.NewInt(totalFinedLoc), totalFined)
+
+	s.SetRoundConsistencyCheck(true)
+	s.setStateBigInt(big.NewInt(upgradedLoc), big.NewInt(1))
+}
+
+// Upgraded returns whether Upgrade has been run on the state.
+func (s *GovernanceState) Upgraded() bool {
+	return s.getStateBigInt(big.NewInt(upgradedLoc)).Cmp(big.NewInt(0)) != 0
+}
+
 // Register is a helper function for creating genesis state.
 func (s *GovernanceState) Register(
 	addr common.Address, publicKey []byte,
@@ -1011,22 +1595,85 @@ func (s *GovernanceState) Disqualify(n *nodeInfo) error {
 	}
 
 	// Set fined value.
+	s.addFine(offset, s.FineValue(big.NewInt(FineTypeFailStop)))
+
+	return nil
+}
+
+// FineCap returns the most node can owe in fines. It is fineCapMultiple times
+// MinStake when set, and the node's stake otherwise.
+func (s *GovernanceState) FineCap(node *nodeInfo) *big.Int {
+	if multiple := s.FineCapMultiple(); multiple.Cmp(big.NewInt(0)) > 0 {
+		return new(big.Int).Mul(multiple, s.MinStake())
+	}
+	return node.Staked
+}
+
+// cappedFine returns the part of amount that can be added to the fine of node
+// without exceeding FineCap. Fines are not capped before the Dexcon upgrade.
+func (s *GovernanceState) cappedFine(node *nodeInfo, amount *big.Int) *big.Int {
+	if !s.Upgraded() {
+		return amount
+	}
+	room := new(big.Int).Sub(s.FineCap(node), node.Fined)
+	if room.Cmp(big.NewInt(0)) < 0 {
+		return big.NewInt(0)
+	}
+	if amount.Cmp(room) > 0 {
+		return room
+	}
+	return amount
+}
+
+// addFine adds amount, capped by cappedFine, to the fine of the node at offset
+// and returns the amount actually applied.
+func (s *GovernanceState) addFine(offset, amount *big.Int) *big.Int {
 	node := s.Node(offset)
-	amount := s.FineValue(big.NewInt(FineTypeFailStop))
+	amount = s.cappedFine(node, amount)
 	node.Fined = new(big.Int).Add(node.Fined, amount)
 	s.UpdateNode(offset, node)
-
-	return nil
+	s.IncTotalFined(amount)
+	return amount
 }
 
 const decimalMultiplier = 100000000.0
 
-// Configuration returns the current configuration.
+// MiningVelocityMultiplier returns the scale of the fixed-point mining
+// velocity kept in state.
+func MiningVelocityMultiplier() *big.Int {
+	return big.NewInt(decimalMultiplier)
+}
+
+// miningVelocityToFixed scales the shortest decimal form of v exactly rather
+// than multiplying in float32, which loses precision past 24 bits.
+func miningVelocityToFixed(v float32) *big.Int {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	if !ok {
+		return big.NewInt(0)
+	}
+	r.Mul(r, new(big.Rat).SetInt(MiningVelocityMultiplier()))
+	return new(big.Int).Quo(r.Num(), r.Denom())
+}
+
+// miningVelocityFromFixed returns the float32 nearest to the fixed-point
+// mining velocity x.
+func miningVelocityFromFixed(x *big.Int) float32 {
+	f, _ := new(big.Rat).SetFrac(x, MiningVelocityMultiplier()).Float32()
+	return f
+}
+
+// Configuration returns the current configuration. After the Dexcon upgrade
+// the mining velocity is converted exactly, before it through float32 as
+// genesis and existing blocks did.
 func (s *GovernanceState) Configuration() *params.DexconConfig {
+	miningVelocity := float32(s.getStateBigInt(big.NewInt(miningVelocityLoc)).Uint64()) / decimalMultiplier
+	if s.Upgraded() {
+		miningVelocity = miningVelocityFromFixed(s.getStateBigInt(big.NewInt(miningVelocityLoc)))
+	}
 	return &params.DexconConfig{
 		MinStake:          s.getStateBigInt(big.NewInt(minStakeLoc)),
 		LockupPeriod:      s.getStateBigInt(big.NewInt(lockupPeriodLoc)).Uint64(),
-		MiningVelocity:    float32(s.getStateBigInt(big.NewInt(miningVelocityLoc)).Uint64()) / decimalMultiplier,
+		MiningVelocity:    miningVelocity,
 		NextHalvingSupply: s.getStateBigInt(big.NewInt(nextHalvingSupplyLoc)),
 		LastHalvedAmount:  s.getStateBigInt(big.NewInt(lastHalvedAmountLoc)),
 		MinGasPrice:       s.getStateBigInt(big.NewInt(minGasPriceLoc)),
@@ -1042,11 +1689,16 @@ func (s *GovernanceState) Configuration() *params.DexconConfig {
 	}
 }
 
-// UpdateConfiguration updates system configuration.
+// UpdateConfiguration updates system configuration. The mining velocity is
+// converted like in Configuration.
 func (s *GovernanceState) UpdateConfiguration(cfg *params.DexconConfig) {
+	miningVelocity := big.NewInt(int64(cfg.MiningVelocity * decimalMultiplier))
+	if s.Upgraded() {
+		miningVelocity = miningVelocityToFixed(cfg.MiningVelocity)
+	}
 	s.setStateBigInt(big.NewInt(minStakeLoc), cfg.MinStake)
 	s.setStateBigInt(big.NewInt(lockupPeriodLoc), big.NewInt(int64(cfg.LockupPeriod)))
-	s.setStateBigInt(big.NewInt(miningVelocityLoc), big.NewInt(int64(cfg.MiningVelocity*decimalMultiplier)))
+	s.setStateBigInt(big.NewInt(miningVelocityLoc), miningVelocity)
 	s.setStateBigInt(big.NewInt(nextHalvingSupplyLoc), cfg.NextHalvingSupply)
 	s.setStateBigInt(big.NewInt(lastHalvedAmountLoc), cfg.LastHalvedAmount)
 	s.setStateBigInt(big.NewInt(minGasPriceLoc), cfg.MinGasPrice)
@@ -1078,8 +1730,29 @@ type rawConfigStruct struct {
 	FineValues       []*big.Int
 }
 
+// rawConfiguration returns the current configuration as stored.
+func (s *GovernanceState) rawConfiguration() *rawConfigStruct {
+	return &rawConfigStruct{
+		MinStake:         s.getStateBigInt(big.NewInt(minStakeLoc)),
+		LockupPeriod:     s.getStateBigInt(big.NewInt(lockupPeriodLoc)),
+		BlockGasLimit:    s.getStateBigInt(big.NewInt(blockGasLimitLoc)),
+		MinGasPrice:      s.getStateBigInt(big.NewInt(minGasPriceLoc)),
+		LambdaBA:         s.getStateBigInt(big.NewInt(lambdaBALoc)),
+		LambdaDKG:        s.getStateBigInt(big.NewInt(lambdaDKGLoc)),
+		NotaryParamAlpha: s.getStateBigInt(big.NewInt(notaryParamAlphaLoc)),
+		NotaryParamBeta:  s.getStateBigInt(big.NewInt(notaryParamBetaLoc)),
+		RoundLength:      s.getStateBigInt(big.NewInt(roundLengthLoc)),
+		MinBlockInterval: s.getStateBigInt(big.NewInt(minBlockIntervalLoc)),
+		FineValues:       s.FineValues(),
+	}
+}
+
 // UpdateConfigurationRaw updates system configuration.
 func (s *GovernanceState) UpdateConfigurationRaw(cfg *rawConfigStruct) {
+	// Nodes near the threshold may become unqualified, let them know.
+	if oldMinStake := s.MinStake(); oldMinStake.Cmp(cfg.MinStake) != 0 {
+		s.emitMinStakeChanged(oldMinStake, cfg.MinStake)
+	}
 	s.setStateBigInt(big.NewInt(minStakeLoc), cfg.MinStake)
 	s.setStateBigInt(big.NewInt(lockupPeriodLoc), cfg.LockupPeriod)
 	s.setStateBigInt(big.NewInt(minGasPriceLoc), cfg.MinGasPrice)
@@ -1097,11 +1770,37 @@ func (s *GovernanceState) UpdateConfigurationRaw(cfg *rawConfigStruct) {
 }
 
 // event ConfigurationChanged();
+//
+// After the Dexcon upgrade the data carries the full new configuration,
+// encoded the same way as the arguments of updateConfiguration. Before it the
+// data stays empty so receipts match the ones already on chain.
 func (s *GovernanceState) emitConfigurationChangedEvent() {
+	data := []byte{}
+	if s.Upgraded() {
+		cfg := s.rawConfiguration()
+		var err error
+		data, err = GovernanceABI.ABI.Methods["updateConfiguration"].Inputs.Pack(
+			cfg.MinStake, cfg.LockupPeriod, cfg.BlockGasLimit, cfg.MinGasPrice,
+			cfg.LambdaBA, cfg.LambdaDKG, cfg.NotaryParamAlpha, cfg.NotaryParamBeta,
+			cfg.RoundLength, cfg.MinBlockInterval, cfg.FineValues)
+		if err != nil {
+			panic(err)
+		}
+	}
 	s.StateDB.AddLog(&types.Log{
 		Address: GovernanceContractAddress,
 		Topics:  []common.Hash{GovernanceABI.Events["ConfigurationChanged"].Id()},
-		Data:    []byte{},
+		Data:    data,
+	})
+}
+
+// event MinStakeChanged(uint256 OldMinStake, uint256 NewMinStake);
+func (s *GovernanceState) emitMinStakeChanged(oldMinStake, newMinStake *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["MinStakeChanged"].Id()},
+		Data: append(common.BigToHash(oldMinStake).Bytes(),
+			common.BigToHash(newMinStake).Bytes()...),
 	})
 }
 
@@ -1142,12 +1841,23 @@ func (s *GovernanceState) emitStaked(nodeAddr common.Address, amount *big.Int) {
 	})
 }
 
-// event Unstaked(address indexed NodeAddress, uint256 Amount);
-func (s *GovernanceState) emitUnstaked(nodeAddr common.Address, amount *big.Int) {
+// event Unstaked(address indexed NodeAddress, uint256 Amount, uint256 Fee);
+//
+// Before the Dexcon upgrade the event is Unstaked(address indexed NodeAddress,
+// uint256 Amount) and fee is ignored.
+func (s *GovernanceState) emitUnstaked(nodeAddr common.Address, amount, fee *big.Int) {
+	if !s.Upgraded() {
+		s.StateDB.AddLog(&types.Log{
+			Address: GovernanceContractAddress,
+			Topics:  []common.Hash{legacyUnstakedEventId, nodeAddr.Hash()},
+			Data:    common.BigToHash(amount).Bytes(),
+		})
+		return
+	}
 	s.StateDB.AddLog(&types.Log{
 		Address: GovernanceContractAddress,
 		Topics:  []common.Hash{GovernanceABI.Events["Unstaked"].Id(), nodeAddr.Hash()},
-		Data:    common.BigToHash(amount).Bytes(),
+		Data:    append(common.BigToHash(amount).Bytes(), common.BigToHash(fee).Bytes()...),
 	})
 }
 
@@ -1160,8 +1870,84 @@ func (s *GovernanceState) emitWithdrawn(nodeAddr common.Address, amount *big.Int
 	})
 }
 
-// event NodeAdded(address indexed NodeAddress);
-func (s *GovernanceState) emitNodeAdded(nodeAddr common.Address) {
+// event DKGMasterPublicKeyAdded(address indexed Proposer);
+//
+// Only emitted after the Dexcon upgrade.
+func (s *GovernanceState) emitDKGMasterPublicKeyAdded(proposer common.Address) {
+	if !s.Upgraded() {
+		return
+	}
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["DKGMasterPublicKeyAdded"].Id(), proposer.Hash()},
+		Data:    []byte{},
+	})
+}
+
+// event DKGComplaintAdded(address indexed Proposer);
+//
+// Only emitted after the Dexcon upgrade.
+func (s *GovernanceState) emitDKGComplaintAdded(proposer common.Address) {
+	if !s.Upgraded() {
+		return
+	}
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["DKGComplaintAdded"].Id(), proposer.Hash()},
+		Data:    []byte{},
+	})
+}
+
+// event DKGReadyAdded(address indexed Proposer);
+//
+// Only emitted after the Dexcon upgrade.
+func (s *GovernanceState) emitDKGReadyAdded(proposer common.Address) {
+	if !s.Upgraded() {
+		return
+	}
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["DKGReadyAdded"].Id(), proposer.Hash()},
+		Data:    []byte{},
+	})
+}
+
+// event DKGFinalizeAdded(address indexed Proposer);
+//
+// Only emitted after the Dexcon upgrade.
+func (s *GovernanceState) emitDKGFinalizeAdded(proposer common.Address) {
+	if !s.Upgraded() {
+		return
+	}
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["DKGFinalizeAdded"].Id(), proposer.Hash()},
+		Data:    []byte{},
+	})
+}
+
+// event DKGRewardClaimed(address indexed NodeAddress, uint256 indexed Round, uint256 Amount);
+func (s *GovernanceState) emitDKGRewardClaimed(nodeAddr common.Address, round, amount *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics: []common.Hash{
+			GovernanceABI.Events["DKGRewardClaimed"].Id(), nodeAddr.Hash(), common.BigToHash(round)},
+		Data: common.BigToHash(amount).Bytes(),
+	})
+}
+
+// event CRSProposerRewarded(address indexed Proposer, uint256 indexed Round, uint256 Amount);
+func (s *GovernanceState) emitCRSProposerRewarded(proposer common.Address, round, amount *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics: []common.Hash{
+			GovernanceABI.Events["CRSProposerRewarded"].Id(), proposer.Hash(), common.BigToHash(round)},
+		Data: common.BigToHash(amount).Bytes(),
+	})
+}
+
+// event NodeAdded(address indexed NodeAddress);
+func (s *GovernanceState) emitNodeAdded(nodeAddr common.Address) {
 	s.StateDB.AddLog(&types.Log{
 		Address: GovernanceContractAddress,
 		Topics:  []common.Hash{GovernanceABI.Events["NodeAdded"].Id(), nodeAddr.Hash()},
@@ -1228,11 +2014,78 @@ func (s *GovernanceState) emitFined(nodeAddr common.Address, amount *big.Int) {
 	})
 }
 
-// event FinePaid(address indexed NodeAddress, uint256 Amount);
-func (s *GovernanceState) emitFinePaid(nodeAddr common.Address, amount *big.Int) {
+// event Slashed(address indexed NodeAddress, uint256 Amount);
+func (s *GovernanceState) emitSlashed(nodeAddr common.Address, amount *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["Slashed"].Id(), nodeAddr.Hash()},
+		Data:    common.BigToHash(amount).Bytes(),
+	})
+}
+
+// event FinePaid(address indexed NodeAddress, address indexed Payer, uint256 Amount);
+//
+// Before the Dexcon upgrade the event is FinePaid(address indexed
+// NodeAddress, uint256 Amount) and payer is ignored.
+func (s *GovernanceState) emitFinePaid(nodeAddr, payer common.Address, amount *big.Int) {
+	if !s.Upgraded() {
+		s.StateDB.AddLog(&types.Log{
+			Address: GovernanceContractAddress,
+			Topics:  []common.Hash{legacyFinePaidEventId, nodeAddr.Hash()},
+			Data:    common.BigToHash(amount).Bytes(),
+		})
+		return
+	}
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["FinePaid"].Id(), nodeAddr.Hash(), payer.Hash()},
+		Data:    common.BigToHash(amount).Bytes(),
+	})
+}
+
+// event FineForgiven(address indexed NodeAddress, uint256 Amount);
+func (s *GovernanceState) emitFineForgiven(nodeAddr common.Address, amount *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["FineForgiven"].Id(), nodeAddr.Hash()},
+		Data:    common.BigToHash(amount).Bytes(),
+	})
+}
+
+// event ForceUnstaked(address indexed NodeAddress, uint256 Amount, uint256 Fine);
+func (s *GovernanceState) emitForceUnstaked(nodeAddr common.Address, amount, fine *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["ForceUnstaked"].Id(), nodeAddr.Hash()},
+		Data:    append(common.BigToHash(amount).Bytes(), common.BigToHash(fine).Bytes()...),
+	})
+}
+
+// event OwnershipTransferStarted(address indexed PreviousOwner, address indexed NewOwner);
+func (s *GovernanceState) emitOwnershipTransferStarted(previousOwner, newOwner common.Address) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics: []common.Hash{GovernanceABI.Events["OwnershipTransferStarted"].Id(),
+			previousOwner.Hash(), newOwner.Hash()},
+		Data: []byte{},
+	})
+}
+
+// event OwnershipTransferred(address indexed PreviousOwner, address indexed NewOwner);
+func (s *GovernanceState) emitOwnershipTransferred(previousOwner, newOwner common.Address) {
 	s.StateDB.AddLog(&types.Log{
 		Address: GovernanceContractAddress,
-		Topics:  []common.Hash{GovernanceABI.Events["FinePaid"].Id(), nodeAddr.Hash()},
+		Topics: []common.Hash{GovernanceABI.Events["OwnershipTransferred"].Id(),
+			previousOwner.Hash(), newOwner.Hash()},
+		Data: []byte{},
+	})
+}
+
+// event TreasurySwept(address indexed Treasury, uint256 Amount);
+func (s *GovernanceState) emitTreasurySwept(treasury common.Address, amount *big.Int) {
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["TreasurySwept"].Id(), treasury.Hash()},
 		Data:    common.BigToHash(amount).Bytes(),
 	})
 }
@@ -1246,6 +2099,19 @@ func (s *GovernanceState) emitDKGReset(round *big.Int, blockHeight *big.Int) {
 	})
 }
 
+// event PausedStateChanged(bool Paused);
+func (s *GovernanceState) emitPausedStateChanged(paused bool) {
+	value := int64(0)
+	if paused {
+		value = int64(1)
+	}
+	s.StateDB.AddLog(&types.Log{
+		Address: GovernanceContractAddress,
+		Topics:  []common.Hash{GovernanceABI.Events["PausedStateChanged"].Id()},
+		Data:    common.BigToHash(big.NewInt(value)).Bytes(),
+	})
+}
+
 func getRoundState(evm *EVM, round *big.Int) (*GovernanceState, error) {
 	gs := &GovernanceState{evm.StateDB}
 	height := gs.RoundHeight(round).Uint64()
@@ -1258,16 +2124,24 @@ func getRoundState(evm *EVM, round *big.Int) (*GovernanceState, error) {
 	return &GovernanceState{statedb}, err
 }
 
-func getConfigState(evm *EVM, round *big.Int) (*GovernanceState, error) {
-	configRound := big.NewInt(0)
+// configRound returns the round whose state holds the configuration for
+// round, that is round - ConfigRoundShift clamped at zero.
+func configRound(round *big.Int) *big.Int {
 	if round.Uint64() > dexCore.ConfigRoundShift {
-		configRound = new(big.Int).Sub(round, big.NewInt(int64(dexCore.ConfigRoundShift)))
+		return new(big.Int).Sub(round, big.NewInt(int64(dexCore.ConfigRoundShift)))
 	}
-	return getRoundState(evm, configRound)
+	return big.NewInt(0)
+}
+
+func getConfigState(evm *EVM, round *big.Int) (*GovernanceState, error) {
+	return getRoundState(evm, configRound(round))
 }
 
 type coreDKGUtils interface {
 	NewGroupPublicKey(*GovernanceState, *big.Int, int) (tsigVerifierIntf, error)
+	DKGMasterPublicKeyItems(*GovernanceState) []*dkgTypes.MasterPublicKey
+	DKGMasterPublicKeyItem(*GovernanceState, *big.Int) (*dkgTypes.MasterPublicKey, error)
+	Purge()
 }
 type tsigVerifierIntf interface {
 	VerifySignature(coreCommon.Hash, coreCrypto.Signature) bool
@@ -1281,9 +2155,71 @@ type GovernanceContract struct {
 	coreDKGUtils coreDKGUtils
 }
 
+// dkgMPKCache holds the decoded DKG master public keys of a state, so each of
+// them is decoded at most once per contract call.
+type dkgMPKCache struct {
+	stateDB StateDB
+	round   *big.Int
+	// items is aligned with dkgMasterPublicKeys, undecodable entries are nil.
+	items []*dkgTypes.MasterPublicKey
+}
+
 // defaultCoreDKGUtils implements coreDKGUtils.
 type defaultCoreDKGUtils struct {
-	gpk atomic.Value
+	gpk  atomic.Value
+	mpks dkgMPKCache
+}
+
+func (c *defaultCoreDKGUtils) loadDKGMasterPublicKeys(
+	state *GovernanceState) []*dkgTypes.MasterPublicKey {
+	round := state.DKGRound()
+	length := int(state.LenDKGMasterPublicKeys().Uint64())
+	if c.mpks.stateDB != state.StateDB || c.mpks.round == nil ||
+		c.mpks.round.Cmp(round) != 0 || len(c.mpks.items) > length {
+		c.mpks = dkgMPKCache{stateDB: state.StateDB, round: round}
+	}
+
+	// Master public keys are only appended within a DKG round, so only the
+	// new entries need decoding.
+	for i := len(c.mpks.items); i < length; i++ {
+		mpk, err := state.DKGMasterPublicKeyItem(big.NewInt(int64(i)))
+		if err != nil {
+			log.Debug("Skip undecodable DKG master public key", "err", err)
+		}
+		c.mpks.items = append(c.mpks.items, mpk)
+	}
+	return c.mpks.items
+}
+
+// DKGMasterPublicKeyItems returns the decodable DKG master public keys of state.
+func (c *defaultCoreDKGUtils) DKGMasterPublicKeyItems(
+	state *GovernanceState) []*dkgTypes.MasterPublicKey {
+	var mpks []*dkgTypes.MasterPublicKey
+	for _, mpk := range c.loadDKGMasterPublicKeys(state) {
+		if mpk != nil {
+			mpks = append(mpks, mpk)
+		}
+	}
+	return mpks
+}
+
+// DKGMasterPublicKeyItem returns the DKG master public key at offset of state.
+func (c *defaultCoreDKGUtils) DKGMasterPublicKeyItem(
+	state *GovernanceState, offset *big.Int) (*dkgTypes.MasterPublicKey, error) {
+	mpks := c.loadDKGMasterPublicKeys(state)
+	if !offset.IsInt64() || offset.Int64() < 0 || offset.Int64() >= int64(len(mpks)) {
+		return nil, errors.New("invalid master public key offset")
+	}
+	if mpks[offset.Int64()] == nil {
+		return nil, errors.New("undecodable master public key")
+	}
+	return mpks[offset.Int64()], nil
+}
+
+// Purge drops the decoded master public keys. It must be called whenever the
+// DKG master public keys are cleared.
+func (c *defaultCoreDKGUtils) Purge() {
+	c.mpks = dkgMPKCache{}
 }
 
 func (c *defaultCoreDKGUtils) NewGroupPublicKey(
@@ -1299,7 +2235,7 @@ func (c *defaultCoreDKGUtils) NewGroupPublicKey(
 		}
 	}
 
-	mpks := state.DKGMasterPublicKeyItems()
+	mpks := c.DKGMasterPublicKeyItems(state)
 	comps := state.DKGComplaintItems()
 	gpk, err = dkgTypes.NewGroupPublicKey(round.Uint64(), mpks, comps, threshold)
 	if err != nil {
@@ -1309,6 +2245,16 @@ func (c *defaultCoreDKGUtils) NewGroupPublicKey(
 	return gpk, nil
 }
 
+// revertWithReason returns errExecutionReverted along with the reason encoded
+// as a Solidity style Error(string) revert payload.
+func revertWithReason(reason string) ([]byte, error) {
+	data, err := revertReasonArguments.Pack(reason)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	return append(append([]byte{}, revertReasonSelector...), data...), errExecutionReverted
+}
+
 func (g *GovernanceContract) Address() common.Address {
 	return GovernanceContractAddress
 }
@@ -1329,6 +2275,19 @@ func (g *GovernanceContract) useGas(gas uint64) ([]byte, error) {
 	return nil, nil
 }
 
+// dkgThreshold returns the threshold of the threshold signature of the DKG
+// set of round.
+func (g *GovernanceContract) dkgThreshold(round *big.Int) int {
+	return coreUtils.GetDKGThreshold(&coreTypes.Config{
+		NotarySetSize: uint32(g.configNotarySetSize(round).Uint64())})
+}
+
+// dkgByzantineThreshold returns 2f + 1 of the DKG set of round, the number of
+// MPKReady or Finalize needed to close the corresponding DKG phase.
+func (g *GovernanceContract) dkgByzantineThreshold(round *big.Int) uint64 {
+	return 2*g.configNotarySetSize(round).Uint64()/3 + 1
+}
+
 func (g *GovernanceContract) configNotarySetSize(round *big.Int) *big.Int {
 	s, err := getConfigState(g.evm, round)
 	if err != nil {
@@ -1337,30 +2296,40 @@ func (g *GovernanceContract) configNotarySetSize(round *big.Int) *big.Int {
 	return s.NotarySetSize()
 }
 
-func (g *GovernanceContract) getNotarySet(round *big.Int) map[coreTypes.NodeID]struct{} {
+// roundCRS returns the CRS of round. CRS(n) = hash(CRS(n-1)) for rounds up to
+// core.DKGDelayRound.
+func (g *GovernanceContract) roundCRS(round *big.Int) (common.Hash, error) {
 	crsRound := g.state.CRSRound()
 	var crs common.Hash
 	cmp := round.Cmp(crsRound)
 	if round.Cmp(big.NewInt(int64(dexCore.DKGDelayRound))) <= 0 {
 		state, err := getRoundState(g.evm, big.NewInt(0))
 		if err != nil {
-			return map[coreTypes.NodeID]struct{}{}
+			return common.Hash{}, err
 		}
 		crs = state.CRS()
 		for i := uint64(0); i < round.Uint64(); i++ {
 			crs = crypto.Keccak256Hash(crs[:])
 		}
 	} else if cmp > 0 {
-		return map[coreTypes.NodeID]struct{}{}
+		return common.Hash{}, errExecutionReverted
 	} else if cmp == 0 {
 		crs = g.state.CRS()
 	} else {
 		state, err := getRoundState(g.evm, round)
 		if err != nil {
-			return map[coreTypes.NodeID]struct{}{}
+			return common.Hash{}, err
 		}
 		crs = state.CRS()
 	}
+	return crs, nil
+}
+
+func (g *GovernanceContract) getNotarySet(round *big.Int) map[coreTypes.NodeID]struct{} {
+	crs, err := g.roundCRS(round)
+	if err != nil {
+		return map[coreTypes.NodeID]struct{}{}
+	}
 
 	target := coreTypes.NewNotarySetTarget(coreCommon.Hash(crs))
 	ns := coreTypes.NewNodeSet()
@@ -1372,7 +2341,8 @@ func (g *GovernanceContract) getNotarySet(round *big.Int) map[coreTypes.NodeID]s
 	for _, x := range state.QualifiedNodes() {
 		mpk, err := ecdsa.NewPublicKeyFromByteSlice(x.PublicKey)
 		if err != nil {
-			panic(err)
+			log.Debug("Skip node with invalid public key", "owner", x.Owner, "err", err)
+			continue
 		}
 		ns.Add(coreTypes.NewNodeID(mpk))
 	}
@@ -1385,10 +2355,69 @@ func (g *GovernanceContract) inNotarySet(round *big.Int, nodeID coreTypes.NodeID
 	return ok
 }
 
+// notarySetNodeKeyAddresses returns the node key addresses of the notary set
+// of round, sorted so the result is deterministic.
+func (g *GovernanceContract) notarySetNodeKeyAddresses(round *big.Int) []common.Address {
+	addrs := []common.Address{}
+	for id := range g.getNotarySet(round) {
+		addrs = append(addrs, IdToAddress(id))
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs
+}
+
+// qualifiedNodeKeyAddresses returns the node key addresses of all nodes
+// qualified in state, in node order. Unlike the notary set, no random
+// selection is applied.
+func (g *GovernanceContract) qualifiedNodeKeyAddresses(state *GovernanceState) []common.Address {
+	addrs := []common.Address{}
+	for _, node := range state.QualifiedNodes() {
+		addr, err := publicKeyToNodeKeyAddress(node.PublicKey)
+		if err != nil {
+			log.Debug("Skip node with invalid public key", "owner", node.Owner, "err", err)
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// isInSet handles the set membership read methods. It unpacks a round and a
+// node key address from arguments and returns whether the address is in the
+// set getSet returns for the round.
+func (g *GovernanceContract) isInSet(
+	method abi.Method, arguments []byte,
+	getSet func(round *big.Int) map[coreTypes.NodeID]struct{}) ([]byte, error) {
+	args := struct {
+		Round          *big.Int
+		NodeKeyAddress common.Address
+	}{}
+	if err := method.Inputs.Unpack(&args, arguments); err != nil {
+		return nil, errExecutionReverted
+	}
+	in := false
+	for id := range getSet(args.Round) {
+		if IdToAddress(id) == args.NodeKeyAddress {
+			in = true
+			break
+		}
+	}
+	res, err := method.Outputs.Pack(in)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	return res, nil
+}
+
 func (g *GovernanceContract) clearDKG() {
 	dkgSet := g.getNotarySet(g.state.DKGRound())
-	g.state.ClearDKGMasterPublicKeyOffset()
+	for _, mpk := range g.coreDKGUtils.DKGMasterPublicKeyItems(&g.state) {
+		g.state.PutDKGMasterPublicKeyOffset(getDKGMasterPublicKeyID(mpk), big.NewInt(-1))
+	}
 	g.state.ClearDKGMasterPublicKeys()
+	g.coreDKGUtils.Purge()
 	g.state.ClearDKGComplaintProposed()
 	g.state.ClearDKGComplaints()
 	g.state.ClearDKGMPKReadys(dkgSet)
@@ -1413,7 +2442,8 @@ func (g *GovernanceContract) fineFailStopDKG(threshold int) {
 	for _, complaint := range g.state.DKGComplaints() {
 		comp := new(dkgTypes.Complaint)
 		if err := rlp.DecodeBytes(complaint, comp); err != nil {
-			panic(err)
+			log.Debug("Skip undecodable DKG complaint", "err", err)
+			continue
 		}
 
 		if comp.IsNack() {
@@ -1442,9 +2472,10 @@ func (g *GovernanceContract) fineFailStopDKG(threshold int) {
 		}
 
 		node := g.state.Node(offset)
-		amount := g.state.FineValue(big.NewInt(FineTypeFailStopDKG))
-		node.Fined = new(big.Int).Add(node.Fined, amount)
-		g.state.UpdateNode(offset, node)
+		amount := g.state.addFine(offset, g.state.FineValue(big.NewInt(FineTypeFailStopDKG)))
+		if g.state.Upgraded() && amount.Cmp(big.NewInt(0)) == 0 {
+			continue
+		}
 		g.state.emitFined(node.Owner, amount)
 	}
 }
@@ -1463,11 +2494,8 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 		return nil, errExecutionReverted
 	}
 
-	// Calculate 2f + 1
-	threshold := 2*g.configNotarySetSize(g.evm.Round).Uint64()/3 + 1
-
 	// If 2f + 1 of DKG set is finalized, one can not propose complaint anymore.
-	if g.state.DKGFinalizedsCount().Uint64() >= threshold {
+	if g.state.DKGFinalizedsCount().Uint64() >= g.dkgByzantineThreshold(g.evm.Round) {
 		return nil, errExecutionReverted
 	}
 
@@ -1493,24 +2521,15 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 		return nil, errExecutionReverted
 	}
 
-	verified, _ := coreUtils.VerifyDKGComplaintSignature(&dkgComplaint)
-	if !verified {
-		return nil, errExecutionReverted
-	}
-
-	mpkOffset := g.state.DKGMasterPublicKeyOffset(Bytes32(dkgComplaint.PrivateShare.ProposerID.Hash))
-	mpk := g.state.DKGMasterPublicKeyItem(mpkOffset)
-
-	// Verify DKG complaint is correct.
-	ok, err := coreUtils.VerifyDKGComplaint(&dkgComplaint, mpk)
-	if !ok || err != nil {
-		return nil, errExecutionReverted
+	// Bound the complaints NewGroupPublicKey has to decode.
+	if g.state.LenDKGComplaints().Cmp(g.maxDKGComplaints(round)) >= 0 {
+		return revertWithReason("too many complaints")
 	}
 
 	// Fine the attacker.
-	need, err := coreUtils.NeedPenaltyDKGPrivateShare(&dkgComplaint, mpk)
+	need, err := g.verifyDKGComplaint(&dkgComplaint)
 	if err != nil {
-		return nil, errExecutionReverted
+		return nil, err
 	}
 	if need {
 		node, err := g.state.GetNodeByID(dkgComplaint.PrivateShare.ProposerID)
@@ -1518,15 +2537,29 @@ func (g *GovernanceContract) addDKGComplaint(comp []byte) ([]byte, error) {
 			return nil, errExecutionReverted
 		}
 		fineValue := g.state.FineValue(big.NewInt(FineTypeInvalidDKG))
-		if err := g.fine(node.Owner, fineValue, comp, nil); err != nil {
+		// The complaint is still recorded for DKG if the node is in cooldown.
+		if err := g.fine(node.Owner, big.NewInt(FineTypeInvalidDKG), fineValue, comp, nil); err != nil &&
+			err != errFinedTooRecently && err != errFineCapReached {
 			return nil, errExecutionReverted
 		}
 	}
 
 	g.state.PushDKGComplaint(comp)
 	g.state.PutDKGComplaintProposed(getDKGComplaintID(&dkgComplaint), true)
+	g.state.emitDKGComplaintAdded(caller)
 
-	return g.useGas(GovernanceActionGasCost)
+	return g.useGas(g.state.DKGComplaintGas().Uint64())
+}
+
+// maxDKGComplaints returns the limit of complaints stored for the DKG of
+// round. Unless configured, it allows every member of the DKG set one nack
+// and one complaint against every other member.
+func (g *GovernanceContract) maxDKGComplaints(round *big.Int) *big.Int {
+	if limit := g.state.MaxDKGComplaints(); limit.Cmp(big.NewInt(0)) > 0 {
+		return limit
+	}
+	size := g.configNotarySetSize(round)
+	return new(big.Int).Mul(big.NewInt(2), new(big.Int).Mul(size, size))
 }
 
 func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
@@ -1563,11 +2596,8 @@ func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
 		return nil, errExecutionReverted
 	}
 
-	// Calculate 2f + 1
-	threshold := 2*g.configNotarySetSize(g.evm.Round).Uint64()/3 + 1
-
 	// If 2f + 1 of DKG set is mpk ready, one can not propose mpk anymore.
-	if g.state.DKGMPKReadysCount().Uint64() >= threshold {
+	if g.state.DKGMPKReadysCount().Uint64() >= g.dkgByzantineThreshold(g.evm.Round) {
 		return nil, errExecutionReverted
 	}
 
@@ -1588,6 +2618,7 @@ func (g *GovernanceContract) addDKGMasterPublicKey(mpk []byte) ([]byte, error) {
 	mpkOffset = g.state.LenDKGMasterPublicKeys()
 	g.state.PushDKGMasterPublicKey(mpk)
 	g.state.PutDKGMasterPublicKeyOffset(getDKGMasterPublicKeyID(&dkgMasterPK), mpkOffset)
+	g.state.emitDKGMasterPublicKeyAdded(caller)
 
 	return g.useGas(GovernanceActionGasCost)
 }
@@ -1621,6 +2652,7 @@ func (g *GovernanceContract) addDKGMPKReady(ready []byte) ([]byte, error) {
 	if !g.state.DKGMPKReady(caller) {
 		g.state.PutDKGMPKReady(caller, true)
 		g.state.IncDKGMPKReadysCount()
+		g.state.emitDKGReadyAdded(caller)
 	}
 
 	return g.useGas(GovernanceActionGasCost)
@@ -1655,14 +2687,14 @@ func (g *GovernanceContract) addDKGFinalize(finalize []byte) ([]byte, error) {
 	if !g.state.DKGFinalized(caller) {
 		g.state.PutDKGFinalized(caller, true)
 		g.state.IncDKGFinalizedsCount()
+		if g.state.Upgraded() {
+			g.state.IncParticipationCount(caller, round)
+		}
+		g.state.emitDKGFinalizeAdded(caller)
 	}
 
-	threshold := 2*g.configNotarySetSize(g.evm.Round).Uint64()/3 + 1
-
-	if g.state.DKGFinalizedsCount().Uint64() == threshold {
-		tsigThreshold := coreUtils.GetDKGThreshold(&coreTypes.Config{
-			NotarySetSize: uint32(g.configNotarySetSize(g.evm.Round).Uint64())})
-		g.fineFailStopDKG(tsigThreshold)
+	if g.state.DKGFinalizedsCount().Uint64() == g.dkgByzantineThreshold(g.evm.Round) {
+		g.fineFailStopDKG(g.dkgThreshold(g.evm.Round))
 	}
 
 	return g.useGas(GovernanceActionGasCost)
@@ -1702,6 +2734,55 @@ func (g *GovernanceContract) addDKGSuccess(success []byte) ([]byte, error) {
 	return g.useGas(GovernanceActionGasCost)
 }
 
+// claimDKGReward pays the DKG reward from the award pool to the caller if it
+// was both ready and finalized in the DKG of round. Each node can claim once
+// per round, and only for rounds since the reward was set.
+func (g *GovernanceContract) claimDKGReward(round *big.Int) ([]byte, error) {
+	caller := g.contract.Caller()
+
+	if round.Cmp(g.state.DKGRewardRound()) < 0 {
+		return revertWithReason("round not rewarded")
+	}
+
+	// The DKG of a past round is kept in the state at the head of that round.
+	var state *GovernanceState
+	switch round.Cmp(g.state.DKGRound()) {
+	case 0:
+		state = &g.state
+	case -1:
+		var err error
+		if state, err = getRoundState(g.evm, round); err != nil {
+			return nil, errExecutionReverted
+		}
+	default:
+		return revertWithReason("DKG not started")
+	}
+
+	if !state.DKGMPKReady(caller) || !state.DKGFinalized(caller) {
+		return revertWithReason("not participated")
+	}
+	if g.state.DKGRewarded(round, caller) {
+		return revertWithReason("already claimed")
+	}
+
+	reward := g.state.DKGReward()
+	if reward.Cmp(big.NewInt(0)) == 0 {
+		return revertWithReason("no reward")
+	}
+	if reward.Cmp(g.state.AwardPool()) > 0 {
+		return revertWithReason("insufficient award pool")
+	}
+
+	g.state.PutDKGRewarded(round, caller)
+	g.state.DecAwardPool(reward)
+	if !g.transfer(GovernanceContractAddress, caller, reward) {
+		return nil, errExecutionReverted
+	}
+	g.state.emitDKGRewardClaimed(caller, round, reward)
+
+	return g.useGas(GovernanceActionGasCost)
+}
+
 func (g *GovernanceContract) updateConfiguration(cfg *rawConfigStruct) ([]byte, error) {
 	// Only owner can update configuration.
 	if g.contract.Caller() != g.state.Owner() {
@@ -1720,293 +2801,908 @@ func (g *GovernanceContract) updateConfiguration(cfg *rawConfigStruct) ([]byte,
 		return nil, errExecutionReverted
 	}
 
+	// Notary set size parameters must yield a usable set size once the
+	// alpha/beta formula takes over at 80 nodes.
+	alpha := float64(cfg.NotaryParamAlpha.Uint64()) / decimalMultiplier
+	beta := float64(cfg.NotaryParamBeta.Uint64()) / decimalMultiplier
+	if setSize := math.Ceil(alpha*math.Log(80) - beta); setSize <= 0 || setSize > 80 {
+		return nil, errExecutionReverted
+	}
+
 	g.state.UpdateConfigurationRaw(cfg)
 	g.state.emitConfigurationChangedEvent()
 
 	return nil, nil
 }
 
-func (g *GovernanceContract) register(
-	publicKey []byte, name, email, location, url string) ([]byte, error) {
-
-	// Reject invalid inputs.
-	if len(name) >= 32 || len(email) >= 32 || len(location) >= 32 || len(url) >= 128 {
+func (g *GovernanceContract) setPaused(paused bool) ([]byte, error) {
+	// Only owner can pause the contract.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
 
-	caller := g.contract.Caller()
-	value := g.contract.Value()
-	offset := g.state.NodesOffsetByAddress(caller)
+	g.state.SetPaused(paused)
+	g.state.emitPausedStateChanged(paused)
 
-	// Can not register if already registered.
-	if offset.Cmp(big.NewInt(0)) >= 0 {
-		return nil, errExecutionReverted
-	}
+	return nil, nil
+}
 
-	nodeKeyAddr, err := publicKeyToNodeKeyAddress(publicKey)
-	if err != nil {
+// setConfigValue updates a single configuration value through setter.
+func (g *GovernanceContract) setConfigValue(value *big.Int, setter func(*big.Int)) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
 
-	offset = g.state.NodesOffsetByNodeKeyAddress(nodeKeyAddr)
-
-	// Can not register if node key is duplicate.
-	if offset.Cmp(big.NewInt(0)) >= 0 {
+	// Sanity checks, same as updateConfiguration.
+	if value.Cmp(big.NewInt(0)) <= 0 {
 		return nil, errExecutionReverted
 	}
 
-	offset = g.state.LenNodes()
-	node := &nodeInfo{
-		Owner:      caller,
-		PublicKey:  publicKey,
-		Staked:     value,
-		Fined:      big.NewInt(0),
-		Name:       name,
-		Email:      email,
-		Location:   location,
-		Url:        url,
-		Unstaked:   big.NewInt(0),
-		UnstakedAt: big.NewInt(0),
-	}
-	g.state.PushNode(node)
-	g.state.PutNodeOffsets(node, offset)
-	g.state.emitNodeAdded(caller)
+	setter(value)
+	g.state.emitConfigurationChangedEvent()
 
-	if value.Cmp(big.NewInt(0)) > 0 {
-		g.state.IncTotalStaked(value)
-		g.state.emitStaked(caller, value)
-	}
-	return g.useGas(GovernanceActionGasCost)
+	return nil, nil
 }
 
-func (g *GovernanceContract) stake() ([]byte, error) {
-	caller := g.contract.Caller()
-	value := g.contract.Value()
-
-	if big.NewInt(0).Cmp(value) == 0 {
+func (g *GovernanceContract) setDKGComplaintGas(gas *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
-
-	offset := g.state.NodesOffsetByAddress(caller)
-	if offset.Cmp(big.NewInt(0)) < 0 {
+	if gas.Cmp(big.NewInt(0)) <= 0 || !gas.IsUint64() {
 		return nil, errExecutionReverted
 	}
 
-	node := g.state.Node(offset)
-	if node.Fined.Cmp(big.NewInt(0)) > 0 {
+	g.state.SetDKGComplaintGas(gas)
+	g.state.emitConfigurationChangedEvent()
+
+	return nil, nil
+}
+
+func (g *GovernanceContract) setAutoGasPrice(enabled bool) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
+	// Bounds must be set before the price can be adjusted automatically.
+	if _, upper := g.state.MinGasPriceBounds(); enabled && upper.Cmp(big.NewInt(0)) == 0 {
 		return nil, errExecutionReverted
 	}
 
-	node.Staked = new(big.Int).Add(node.Staked, value)
-	g.state.UpdateNode(offset, node)
-
-	g.state.IncTotalStaked(value)
-	g.state.emitStaked(caller, value)
+	g.state.SetAutoGasPrice(enabled)
+	g.state.emitConfigurationChangedEvent()
 
-	return g.useGas(GovernanceActionGasCost)
+	return nil, nil
 }
 
-func (g *GovernanceContract) unstake(amount *big.Int) ([]byte, error) {
-	caller := g.contract.Caller()
-
-	offset := g.state.NodesOffsetByAddress(caller)
-	if offset.Cmp(big.NewInt(0)) < 0 {
+func (g *GovernanceContract) setMinGasPriceBounds(lower, upper *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
+	if lower.Cmp(big.NewInt(0)) <= 0 || lower.Cmp(upper) > 0 {
 		return nil, errExecutionReverted
 	}
 
-	node := g.state.Node(offset)
+	g.state.SetMinGasPriceBounds(lower, upper)
+	g.state.emitConfigurationChangedEvent()
 
-	// Can not unstake if there are unpaied fine.
-	if node.Fined.Cmp(big.NewInt(0)) > 0 {
+	return nil, nil
+}
+
+// setMaxDKGComplaints sets the limit of stored DKG complaints. Zero restores
+// the default.
+func (g *GovernanceContract) setMaxDKGComplaints(limit *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
 
-	// Can not unstake if there are unwithdrawn stake.
-	if node.Unstaked.Cmp(big.NewInt(0)) > 0 {
+	g.state.SetMaxDKGComplaints(limit)
+	g.state.emitConfigurationChangedEvent()
+
+	return nil, nil
+}
+
+func (g *GovernanceContract) setDKGResetGracePercent(percent *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
-	if node.Staked.Cmp(amount) < 0 {
+	if percent.Cmp(big.NewInt(0)) <= 0 || percent.Cmp(big.NewInt(100)) > 0 {
 		return nil, errExecutionReverted
 	}
 
-	node.Staked = new(big.Int).Sub(node.Staked, amount)
-	node.Unstaked = amount
-	node.UnstakedAt = g.evm.Time
-	g.state.UpdateNode(offset, node)
-
-	g.state.DecTotalStaked(amount)
-	g.state.emitUnstaked(caller, amount)
+	g.state.SetDKGResetGracePercent(percent)
+	g.state.emitConfigurationChangedEvent()
 
-	return g.useGas(GovernanceActionGasCost)
+	return nil, nil
 }
 
-func (g *GovernanceContract) withdraw() ([]byte, error) {
-	if !g.withdrawable() {
+// setFineCapMultiple sets the cap of outstanding fines in multiples of
+// MinStake. Zero caps fines at the node's stake.
+func (g *GovernanceContract) setFineCapMultiple(multiple *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
-	caller := g.contract.Caller()
 
-	offset := g.state.NodesOffsetByAddress(caller)
-	if offset.Cmp(big.NewInt(0)) < 0 {
+	g.state.SetFineCapMultiple(multiple)
+	g.state.emitConfigurationChangedEvent()
+
+	return nil, nil
+}
+
+// setRoundConsistencyCheck enables or disables assertRoundConsistency.
+func (g *GovernanceContract) setRoundConsistencyCheck(enabled bool) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
 
-	node := g.state.Node(offset)
+	g.state.SetRoundConsistencyCheck(enabled)
+	g.state.emitConfigurationChangedEvent()
 
-	amount := node.Unstaked
-	node.Unstaked = big.NewInt(0)
-	node.UnstakedAt = big.NewInt(0)
-	g.state.UpdateNode(offset, node)
+	return nil, nil
+}
 
-	if node.Staked.Cmp(big.NewInt(0)) == 0 {
-		length := g.state.LenNodes()
-		lastIndex := new(big.Int).Sub(length, big.NewInt(1))
+func (g *GovernanceContract) setReportCooldown(cooldown *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
 
-		// Delete the node.
-		if offset.Cmp(lastIndex) != 0 {
-			lastNode := g.state.Node(lastIndex)
-			g.state.UpdateNode(offset, lastNode)
-			g.state.PutNodeOffsets(lastNode, offset)
-		}
-		g.state.DeleteNodeOffsets(node)
-		g.state.PopLastNode()
-		g.state.emitNodeRemoved(caller)
+	g.state.SetReportCooldown(cooldown)
+	g.state.emitConfigurationChangedEvent()
+
+	return nil, nil
+}
+
+func (g *GovernanceContract) setTreasury(treasury common.Address) ([]byte, error) {
+	// Only owner can update treasury.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
+	if treasury == (common.Address{}) {
+		return nil, errExecutionReverted
 	}
+	g.state.SetTreasury(treasury)
+	return nil, nil
+}
 
-	// Return the staked fund.
-	if !g.transfer(GovernanceContractAddress, node.Owner, amount) {
+// setDKGReward sets the DKG reward. It applies to the DKG of the current DKG
+// round and later ones.
+func (g *GovernanceContract) setDKGReward(reward *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
-	g.state.emitWithdrawn(caller, amount)
 
-	return g.useGas(GovernanceActionGasCost)
-}
+	g.state.SetDKGReward(reward)
+	g.state.SetDKGRewardRound(g.state.DKGRound())
+	g.state.emitConfigurationChangedEvent()
 
-func (g *GovernanceContract) withdrawable() bool {
-	caller := g.contract.Caller()
+	return nil, nil
+}
 
-	offset := g.state.NodesOffsetByAddress(caller)
-	if offset.Cmp(big.NewInt(0)) < 0 {
-		return false
+func (g *GovernanceContract) setCRSProposerReward(reward *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
 	}
 
-	node := g.state.Node(offset)
+	g.state.SetCRSProposerReward(reward)
+	g.state.emitConfigurationChangedEvent()
 
-	// Can not withdraw if there are unpaied fine.
-	if node.Fined.Cmp(big.NewInt(0)) > 0 {
-		return false
-	}
+	return nil, nil
+}
 
-	// Can not withdraw if there are no pending withdrawal.
-	if node.Unstaked.Cmp(big.NewInt(0)) == 0 {
-		return false
+// setUnstakeFee sets the unstake fee in basis points.
+func (g *GovernanceContract) setUnstakeFee(fee *big.Int) ([]byte, error) {
+	// Only owner can update configuration.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
+	if fee.Cmp(big.NewInt(0)) < 0 || fee.Cmp(big.NewInt(10000)) > 0 {
+		return nil, errExecutionReverted
 	}
 
-	unlockTime := new(big.Int).Add(node.UnstakedAt, g.state.LockupPeriod())
-	return g.evm.Time.Cmp(unlockTime) > 0
+	g.state.SetUnstakeFee(fee)
+	g.state.emitConfigurationChangedEvent()
+
+	return nil, nil
 }
 
-func (g *GovernanceContract) payFine(nodeAddr common.Address) ([]byte, error) {
-	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
-	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
+// sweepTreasury moves funds from the award pool to the treasury. Only the
+// award pool can be swept, so stake held by the contract is never touched.
+func (g *GovernanceContract) sweepTreasury(amount *big.Int) ([]byte, error) {
+	if g.contract.Caller() != g.state.Owner() {
 		return nil, errExecutionReverted
 	}
+	treasury := g.state.Treasury()
+	if treasury == (common.Address{}) {
+		return revertWithReason("treasury not set")
+	}
+	if amount.Cmp(g.state.AwardPool()) > 0 {
+		return revertWithReason("insufficient award pool")
+	}
 
-	node := g.state.Node(nodeOffset)
-	if node.Fined.Cmp(big.NewInt(0)) <= 0 || node.Fined.Cmp(g.contract.Value()) < 0 {
+	g.state.DecAwardPool(amount)
+	if !g.transfer(GovernanceContractAddress, treasury, amount) {
 		return nil, errExecutionReverted
 	}
+	g.state.emitTreasurySwept(treasury, amount)
+	return nil, nil
+}
 
-	node.Fined = new(big.Int).Sub(node.Fined, g.contract.Value())
-	g.state.UpdateNode(nodeOffset, node)
+func (g *GovernanceContract) register(
+	publicKey []byte, name, email, location, url string) ([]byte, error) {
 
-	// Pay the fine to governance owner.
-	g.evm.StateDB.AddBalance(g.state.Owner(), g.contract.Value())
+	// Staking is halted while paused.
+	if g.state.Paused() {
+		return revertWithReason("contract paused")
+	}
 
-	g.state.emitFinePaid(nodeAddr, g.contract.Value())
+	// Reject invalid inputs.
+	if len(name) >= 32 || len(email) >= 32 || len(location) >= 32 || len(url) >= 128 {
+		return revertWithReason("invalid node info")
+	}
 
-	return g.useGas(GovernanceActionGasCost)
-}
+	caller := g.contract.Caller()
+	value := g.contract.Value()
+	offset := g.state.NodesOffsetByAddress(caller)
 
-func (g *GovernanceContract) proposeCRS(nextRound *big.Int, signedCRS []byte) ([]byte, error) {
-	if nextRound.Uint64() != g.evm.Round.Uint64()+1 ||
-		g.state.CRSRound().Uint64() == nextRound.Uint64() {
-		return nil, errExecutionReverted
+	// Can not register if already registered.
+	if offset.Cmp(big.NewInt(0)) >= 0 {
+		return revertWithReason("already registered")
 	}
 
-	prevCRS := g.state.CRS()
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(publicKey)
+	if err != nil {
+		return revertWithReason("invalid public key")
+	}
 
-	// CRS(n) = hash(CRS(n-1)) if n <= core.DKGRoundDelay
-	if g.evm.Round.Uint64() == dexCore.DKGDelayRound {
-		for i := uint64(0); i < dexCore.DKGDelayRound; i++ {
-			prevCRS = crypto.Keccak256Hash(prevCRS[:])
+	// The key must also be accepted by consensus core, otherwise building
+	// the notary set would fail.
+	if g.state.Upgraded() {
+		if _, err := ecdsa.NewPublicKeyFromByteSlice(publicKey); err != nil {
+			return revertWithReason("invalid public key")
 		}
 	}
 
-	threshold := coreUtils.GetDKGThreshold(&coreTypes.Config{
-		NotarySetSize: uint32(g.state.NotarySetSize().Uint64())})
-	dkgGPK, err := g.coreDKGUtils.NewGroupPublicKey(&g.state, nextRound, threshold)
-	if err != nil {
-		return nil, errExecutionReverted
+	offset = g.state.NodesOffsetByNodeKeyAddress(nodeKeyAddr)
+
+	// Can not register if node key is duplicate.
+	if offset.Cmp(big.NewInt(0)) >= 0 {
+		return revertWithReason("duplicated public key")
 	}
-	signature := coreCrypto.Signature{
-		Type:      "bls",
-		Signature: signedCRS,
+
+	offset = g.state.LenNodes()
+	node := &nodeInfo{
+		Owner:      caller,
+		PublicKey:  publicKey,
+		Staked:     value,
+		Fined:      big.NewInt(0),
+		Name:       name,
+		Email:      email,
+		Location:   location,
+		Url:        url,
+		Unstaked:   big.NewInt(0),
+		UnstakedAt: big.NewInt(0),
 	}
-	if !dkgGPK.VerifySignature(coreCommon.Hash(prevCRS), signature) {
-		return nil, errExecutionReverted
+	g.state.PushNode(node)
+	g.state.PutNodeOffsets(node, offset)
+	g.state.emitNodeAdded(caller)
+
+	if value.Cmp(big.NewInt(0)) > 0 {
+		g.state.IncTotalStaked(value)
+		g.state.emitStaked(caller, value)
 	}
+	return g.useGas(GovernanceActionGasCost)
+}
 
-	// Save new CRS into state and increase round.
-	crs := crypto.Keccak256Hash(signedCRS)
+// registerWithSignature registers a node like register, but additionally
+// requires sig, a signature by the node key binding it to the caller address
+// (see NodeKeySignatureHash). This lets a cold owner account bind a node key
+// it controls without the node key ever sending a transaction.
+func (g *GovernanceContract) registerWithSignature(
+	publicKey []byte, name, email, location, url string, sig []byte) ([]byte, error) {
+	if _, err := publicKeyToNodeKeyAddress(publicKey); err != nil {
+		return revertWithReason("invalid public key")
+	}
+	if !g.verifyNodeKeySignature(NodeKeySignatureRegister, publicKey, sig) {
+		return revertWithReason("invalid signature")
+	}
+	return g.register(publicKey, name, email, location, url)
+}
 
-	g.state.SetCRS(crs)
-	g.state.SetCRSRound(nextRound)
-	g.state.emitCRSProposed(nextRound, crs)
+// Purposes of node key signatures, see NodeKeySignatureHash.
+const (
+	NodeKeySignatureRegister = "registerWithSignature"
+	NodeKeySignatureRotate   = "rotateNodeKey"
+)
 
-	return g.useGas(GovernanceActionGasCost)
+// NodeKeySignatureHash returns the hash a node key signs to bind itself to
+// owner. The hash covers the chain ID and the purpose of the signature, so it
+// can not be replayed on another network or for another method.
+func NodeKeySignatureHash(chainID *big.Int, purpose string, owner common.Address) []byte {
+	return crypto.Keccak256([]byte("DEXON node key signature:"), []byte(purpose),
+		common.BigToHash(chainID).Bytes(), owner.Bytes())
 }
 
-type sortBytes [][]byte
-
-func (s sortBytes) Less(i, j int) bool {
-	return bytes.Compare(s[i], s[j]) < 0
+// verifyNodeKeySignature returns whether sig is a signature by the key of
+// publicKey over NodeKeySignatureHash of the caller address for purpose.
+func (g *GovernanceContract) verifyNodeKeySignature(purpose string, publicKey, sig []byte) bool {
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(publicKey)
+	if err != nil {
+		return false
+	}
+	hash := NodeKeySignatureHash(g.evm.chainConfig.ChainID, purpose, g.contract.Caller())
+	signer, err := crypto.SigToPub(hash, sig)
+	return err == nil && crypto.PubkeyToAddress(*signer) == nodeKeyAddr
 }
 
-func (s sortBytes) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
+// nonReentrant runs fn with the reentrancy lock held, reverting if it is
+// already held by a call further up the stack. The lock lives on the EVM
+// rather than in state, since it never outlives the call holding it.
+func (g *GovernanceContract) nonReentrant(fn func() ([]byte, error)) ([]byte, error) {
+	if g.evm.governanceLocked {
+		return revertWithReason("reentrant call")
+	}
+	g.evm.governanceLocked = true
+	defer func() { g.evm.governanceLocked = false }()
+	return fn()
 }
 
-func (s sortBytes) Len() int {
-	return len(s)
+func (g *GovernanceContract) stake() ([]byte, error) {
+	return g.stakeAmount(g.contract.Value())
 }
 
-func (g *GovernanceContract) fine(nodeAddr common.Address, amount *big.Int, payloads ...[]byte) error {
-	sort.Sort(sortBytes(payloads))
+// stakeAmount stakes an explicitly stated amount, which must match the value
+// sent, so a caller can not stake more or less than it intended.
+func (g *GovernanceContract) stakeAmount(value *big.Int) ([]byte, error) {
+	if g.state.Paused() {
+		return revertWithReason("contract paused")
+	}
 
-	hash := Bytes32(crypto.Keccak256Hash(payloads...))
-	if g.state.FineRecords(hash) {
-		return errors.New("already fined")
+	caller := g.contract.Caller()
+	if value.Cmp(g.contract.Value()) != 0 {
+		return revertWithReason("amount mismatch")
 	}
-	g.state.SetFineRecords(hash, true)
 
-	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
-	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
-		return errExecutionReverted
+	if big.NewInt(0).Cmp(value) == 0 {
+		return revertWithReason("zero stake")
 	}
 
-	// Set fined value.
-	node := g.state.Node(nodeOffset)
-	node.Fined = new(big.Int).Add(node.Fined, amount)
-	g.state.UpdateNode(nodeOffset, node)
+	offset := g.state.NodesOffsetByAddress(caller)
+	if offset.Cmp(big.NewInt(0)) < 0 {
+		return revertWithReason("node not found")
+	}
 
-	g.state.emitFined(nodeAddr, amount)
+	node := g.state.Node(offset)
+	if node.Fined.Cmp(big.NewInt(0)) > 0 {
+		return revertWithReason("unpaid fine")
+	}
 
-	return nil
-}
+	node.Staked = new(big.Int).Add(node.Staked, value)
+	g.state.UpdateNode(offset, node)
 
-func (g *GovernanceContract) report(reportType *big.Int, arg1, arg2 []byte) ([]byte, error) {
-	typeEnum := FineType(reportType.Uint64())
+	g.state.IncTotalStaked(value)
+	g.state.emitStaked(caller, value)
+
+	return g.useGas(GovernanceActionGasCost)
+}
+
+func (g *GovernanceContract) unstake(amount *big.Int) ([]byte, error) {
+	if g.state.Paused() {
+		return revertWithReason("contract paused")
+	}
+
+	caller := g.contract.Caller()
+
+	offset := g.state.NodesOffsetByAddress(caller)
+	if offset.Cmp(big.NewInt(0)) < 0 {
+		return revertWithReason("node not found")
+	}
+
+	node := g.state.Node(offset)
+
+	// Can not unstake if there are unpaied fine.
+	if node.Fined.Cmp(big.NewInt(0)) > 0 {
+		return revertWithReason("unpaid fine")
+	}
+
+	// Can not unstake if there are unwithdrawn stake.
+	if node.Unstaked.Cmp(big.NewInt(0)) > 0 {
+		return revertWithReason("pending withdrawal")
+	}
+	if node.Staked.Cmp(amount) < 0 {
+		return revertWithReason("insufficient stake")
+	}
+
+	// After the Dexcon upgrade the unstake fee stays in the contract as part
+	// of the award pool, only the rest becomes withdrawable.
+	fee := big.NewInt(0)
+	if g.state.Upgraded() {
+		fee = new(big.Int).Div(
+			new(big.Int).Mul(amount, g.state.UnstakeFee()), big.NewInt(10000))
+	}
+
+	node.Staked = new(big.Int).Sub(node.Staked, amount)
+	node.Unstaked = new(big.Int).Sub(amount, fee)
+	node.UnstakedAt = g.evm.Time
+	g.state.UpdateNode(offset, node)
+
+	if err := g.state.DecTotalStaked(amount); err != nil {
+		return nil, errExecutionReverted
+	}
+	g.state.IncTotalUnstaked(node.Unstaked)
+	if g.state.Upgraded() {
+		g.state.IncAwardPool(fee)
+	}
+	g.state.emitUnstaked(caller, amount, fee)
+
+	return g.useGas(GovernanceActionGasCost)
+}
+
+func (g *GovernanceContract) withdraw() ([]byte, error) {
+	if g.state.Paused() {
+		return revertWithReason("contract paused")
+	}
+	if !g.withdrawable() {
+		return revertWithReason("not withdrawable")
+	}
+	caller := g.contract.Caller()
+
+	offset := g.state.NodesOffsetByAddress(caller)
+	if offset.Cmp(big.NewInt(0)) < 0 {
+		return revertWithReason("node not found")
+	}
+
+	node := g.state.Node(offset)
+
+	amount := node.Unstaked
+	node.Unstaked = big.NewInt(0)
+	node.UnstakedAt = big.NewInt(0)
+	g.state.UpdateNode(offset, node)
+
+	// The counter misses funds unstaked before it existed until the state is
+	// upgraded, withdrawing those must not underflow it.
+	tracked := amount
+	if tracked.Cmp(g.state.TotalUnstaked()) > 0 {
+		tracked = g.state.TotalUnstaked()
+	}
+	if err := g.state.DecTotalUnstaked(tracked); err != nil {
+		return nil, errExecutionReverted
+	}
+
+	if node.Staked.Cmp(big.NewInt(0)) == 0 {
+		g.removeNode(offset, node)
+	}
+
+	// Return the staked fund. Node storage is final at this point, and a
+	// failed transfer reverts the whole call.
+	if !g.transfer(GovernanceContractAddress, node.Owner, amount) {
+		return nil, errExecutionReverted
+	}
+	g.state.emitWithdrawn(caller, amount)
+
+	return g.useGas(GovernanceActionGasCost)
+}
+
+// removeNode deletes the node at offset by moving the last node into its
+// place, and clears the offsets of the removed node.
+func (g *GovernanceContract) removeNode(offset *big.Int, node *nodeInfo) {
+	length := g.state.LenNodes()
+	lastIndex := new(big.Int).Sub(length, big.NewInt(1))
+
+	if offset.Cmp(lastIndex) != 0 {
+		lastNode := g.state.Node(lastIndex)
+		// Erase first so no stale data chunks of longer fields remain.
+		g.state.EraseNode(offset)
+		g.state.UpdateNode(offset, lastNode)
+		g.state.PutNodeOffsets(lastNode, offset)
+	}
+	g.state.DeleteNodeOffsets(node)
+	g.state.PopLastNode()
+	g.state.emitNodeRemoved(node.Owner)
+}
+
+func (g *GovernanceContract) withdrawable() bool {
+	ready, _ := g.lockupRemaining(g.contract.Caller())
+	return ready
+}
+
+// totalWithdrawable returns the sum of the pending withdrawals of the nodes
+// owned by nodeAddrs that can be withdrawn now. Nodes still locked up or with
+// unpaid fines are skipped, and duplicated addresses are counted once.
+func (g *GovernanceContract) totalWithdrawable(nodeAddrs []common.Address) *big.Int {
+	total := big.NewInt(0)
+	seen := make(map[common.Address]struct{}, len(nodeAddrs))
+	for _, addr := range nodeAddrs {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+
+		if ready, _ := g.lockupRemaining(addr); !ready {
+			continue
+		}
+		node := g.state.Node(g.state.NodesOffsetByAddress(addr))
+		total.Add(total, node.Unstaked)
+	}
+	return total
+}
+
+// lockupRemaining returns whether the node owned by nodeAddr can withdraw now
+// and the time its pending unstake unlocks, or zero if there is none.
+//
+// The lockup period in effect now applies, not the one at unstake time, so a
+// configuration change also moves the unlock time of pending withdrawals.
+func (g *GovernanceContract) lockupRemaining(nodeAddr common.Address) (bool, *big.Int) {
+	offset := g.state.NodesOffsetByAddress(nodeAddr)
+	if offset.Cmp(big.NewInt(0)) < 0 {
+		return false, big.NewInt(0)
+	}
+
+	node := g.state.Node(offset)
+
+	// Can not withdraw if there are no pending withdrawal.
+	if node.Unstaked.Cmp(big.NewInt(0)) == 0 {
+		return false, big.NewInt(0)
+	}
+
+	unlockTime := new(big.Int).Add(node.UnstakedAt, g.state.LockupPeriod())
+
+	// Can not withdraw if there are unpaied fine.
+	if node.Fined.Cmp(big.NewInt(0)) > 0 {
+		return false, unlockTime
+	}
+
+	return g.evm.Time.Cmp(unlockTime) > 0, unlockTime
+}
+
+func (g *GovernanceContract) payFine(nodeAddr common.Address) ([]byte, error) {
+	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
+	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
+		return nil, errExecutionReverted
+	}
+
+	node := g.state.Node(nodeOffset)
+	if node.Fined.Cmp(big.NewInt(0)) <= 0 || node.Fined.Cmp(g.contract.Value()) < 0 {
+		return nil, errExecutionReverted
+	}
+
+	node.Fined = new(big.Int).Sub(node.Fined, g.contract.Value())
+	g.state.UpdateNode(nodeOffset, node)
+	if err := g.state.DecTotalFined(g.contract.Value()); err != nil {
+		return nil, errExecutionReverted
+	}
+
+	if g.state.Upgraded() {
+		// The paid fine stays in the contract balance as part of the award pool.
+		g.state.IncAwardPool(g.contract.Value())
+	} else {
+		// Pay the fine to governance owner.
+		g.evm.StateDB.AddBalance(g.state.Owner(), g.contract.Value())
+	}
+
+	// Anyone may pay the fine on behalf of the node.
+	g.state.emitFinePaid(nodeAddr, g.contract.Caller(), g.contract.Value())
+
+	return g.useGas(GovernanceActionGasCost)
+}
+
+// forceUnstake unstakes the whole stake of a fined node regardless of its
+// unpaid fine. The fine is settled from the stake into the award pool and the
+// rest starts its lockup as a regular unstake would.
+func (g *GovernanceContract) forceUnstake(nodeAddr common.Address) ([]byte, error) {
+	// Only owner can force unstake.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
+
+	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
+	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
+		return nil, errExecutionReverted
+	}
+
+	// Only nodes with an unpaid fine can be forced out.
+	node := g.state.Node(nodeOffset)
+	if node.Fined.Cmp(big.NewInt(0)) <= 0 {
+		return nil, errExecutionReverted
+	}
+
+	amount := node.Staked
+	fine := node.Fined
+	if fine.Cmp(amount) > 0 {
+		fine = amount
+	}
+
+	node.Staked = big.NewInt(0)
+	node.Fined = new(big.Int).Sub(node.Fined, fine)
+	node.Unstaked = new(big.Int).Add(node.Unstaked, new(big.Int).Sub(amount, fine))
+	node.UnstakedAt = g.evm.Time
+	g.state.UpdateNode(nodeOffset, node)
+
+	if err := g.state.DecTotalStaked(amount); err != nil {
+		return nil, errExecutionReverted
+	}
+	g.state.IncTotalUnstaked(new(big.Int).Sub(amount, fine))
+	if err := g.state.DecTotalFined(fine); err != nil {
+		return nil, errExecutionReverted
+	}
+	g.state.IncAwardPool(fine)
+
+	g.state.emitForceUnstaked(nodeAddr, amount, fine)
+
+	return nil, nil
+}
+
+func (g *GovernanceContract) forgiveFine(nodeAddr common.Address, amount *big.Int) ([]byte, error) {
+	// Only owner can forgive fine.
+	if g.contract.Caller() != g.state.Owner() {
+		return nil, errExecutionReverted
+	}
+
+	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
+	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
+		return nil, errExecutionReverted
+	}
+
+	node := g.state.Node(nodeOffset)
+	if node.Fined.Cmp(big.NewInt(0)) <= 0 || amount.Cmp(big.NewInt(0)) <= 0 {
+		return nil, errExecutionReverted
+	}
+
+	// Never forgive more than the outstanding fine.
+	if amount.Cmp(node.Fined) > 0 {
+		amount = new(big.Int).Set(node.Fined)
+	}
+
+	node.Fined = new(big.Int).Sub(node.Fined, amount)
+	g.state.UpdateNode(nodeOffset, node)
+	if err := g.state.DecTotalFined(amount); err != nil {
+		return nil, errExecutionReverted
+	}
+
+	g.state.emitFineForgiven(nodeAddr, amount)
+
+	return nil, nil
+}
+
+func (g *GovernanceContract) proposeCRS(nextRound *big.Int, signedCRS []byte) ([]byte, error) {
+	if nextRound.Uint64() != g.evm.Round.Uint64()+1 ||
+		g.state.CRSRound().Uint64() == nextRound.Uint64() {
+		return nil, errExecutionReverted
+	}
+
+	prevCRS := g.state.CRS()
+
+	// CRS(n) = hash(CRS(n-1)) if n <= core.DKGRoundDelay
+	if g.evm.Round.Uint64() == dexCore.DKGDelayRound {
+		for i := uint64(0); i < dexCore.DKGDelayRound; i++ {
+			prevCRS = crypto.Keccak256Hash(prevCRS[:])
+		}
+	}
+
+	threshold := coreUtils.GetDKGThreshold(&coreTypes.Config{
+		NotarySetSize: uint32(g.state.NotarySetSize().Uint64())})
+	dkgGPK, err := g.coreDKGUtils.NewGroupPublicKey(&g.state, nextRound, threshold)
+	if err == dkgTypes.ErrNotReachThreshold {
+		// Not enough master public keys yet, proposing may succeed later.
+		return revertWithReason("threshold not reached")
+	} else if err != nil {
+		return nil, errExecutionReverted
+	}
+	signature := coreCrypto.Signature{
+		Type:      "bls",
+		Signature: signedCRS,
+	}
+	if !dkgGPK.VerifySignature(coreCommon.Hash(prevCRS), signature) {
+		return revertWithReason("invalid signature")
+	}
+
+	// Save new CRS into state and increase round.
+	crs := crypto.Keccak256Hash(signedCRS)
+
+	g.state.SetCRS(crs)
+	g.state.SetCRSRound(nextRound)
+	g.state.emitCRSProposed(nextRound, crs)
+
+	// Refund the proposer the cost of GovernanceActionGasCost at the
+	// transaction gas price. The refund is paid out of the award pool and is
+	// capped by its balance, so stake held by the contract is never used.
+	refund := new(big.Int).Mul(
+		new(big.Int).SetUint64(GovernanceActionGasCost), g.evm.GasPrice)
+	refund = g.state.DrainAwardPool(refund)
+	g.state.StateDB.AddBalance(g.contract.Caller(), refund)
+
+	// The CRS round check above makes sure a round is rewarded only once.
+	// The reward is capped by the award pool like the refund, so a depleted
+	// pool does not stop the CRS from being proposed.
+	reward := g.state.DrainAwardPool(g.state.CRSProposerReward())
+	if reward.Cmp(big.NewInt(0)) > 0 {
+		g.state.StateDB.AddBalance(g.contract.Caller(), reward)
+		g.state.emitCRSProposerRewarded(g.contract.Caller(), nextRound, reward)
+	}
+
+	if err := g.assertRoundConsistency(); err != nil {
+		return revertWithReason(err.Error())
+	}
+	return g.useGas(GovernanceActionGasCost)
+}
+
+// errRoundInconsistent is returned when the CRS round and DKG round drift
+// apart.
+var errRoundInconsistent = errors.New("inconsistent round state")
+
+// assertRoundConsistency checks that the CRS is set for the round following
+// the current one and that the DKG round keeps up with it. Proposing a CRS
+// needs the DKG of the current round, so the DKG round is either the CRS
+// round or the one before it. resetDKG restarts the DKG of the round it sets
+// the CRS for, so after a reset both rounds must match. The check only runs
+// when roundConsistencyCheck is enabled.
+func (g *GovernanceContract) assertRoundConsistency() error {
+	if !g.state.RoundConsistencyCheck() {
+		return nil
+	}
+	crsRound := g.state.CRSRound()
+	if crsRound.Uint64() != g.evm.Round.Uint64()+1 {
+		return errRoundInconsistent
+	}
+	maxLag := big.NewInt(1)
+	if g.state.DKGResetCount(crsRound).Cmp(big.NewInt(0)) > 0 {
+		maxLag = big.NewInt(0)
+	}
+	lag := new(big.Int).Sub(crsRound, g.state.DKGRound())
+	if lag.Cmp(big.NewInt(0)) < 0 || lag.Cmp(maxLag) > 0 {
+		return errRoundInconsistent
+	}
+	return nil
+}
+
+type sortBytes [][]byte
+
+func (s sortBytes) Less(i, j int) bool {
+	return bytes.Compare(s[i], s[j]) < 0
+}
+
+func (s sortBytes) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s sortBytes) Len() int {
+	return len(s)
+}
+
+var errFinedTooRecently = errors.New("fined too recently")
+
+// errFineCapReached is returned by fine when the node already owes FineCap.
+var errFineCapReached = errors.New("fine cap reached")
+
+func (g *GovernanceContract) fine(nodeAddr common.Address, fineType, amount *big.Int, payloads ...[]byte) error {
+	sort.Sort(sortBytes(payloads))
+
+	hash := Bytes32(crypto.Keccak256Hash(payloads...))
+	if g.state.FineRecords(hash) {
+		return errors.New("already fined")
+	}
+
+	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
+	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
+		return errExecutionReverted
+	}
+
+	// The outstanding fine is capped so that a flood of reports can not leave
+	// a node unable to ever pay off and unstake. A report that would not fine
+	// anything is rejected without consuming its evidence.
+	node := g.state.Node(nodeOffset)
+	if g.state.Upgraded() && g.state.cappedFine(node, amount).Cmp(big.NewInt(0)) == 0 {
+		return errFineCapReached
+	}
+
+	// A node can only be fined once per cooldown period for each report type.
+	// The evidence is not recorded here, so it can still be reported after the
+	// cooldown.
+	if g.state.Upgraded() {
+		lastFinedAt := g.state.LastFinedAt(nodeAddr, fineType)
+		if cooldown := g.state.ReportCooldown(); cooldown.Cmp(big.NewInt(0)) > 0 &&
+			lastFinedAt.Cmp(big.NewInt(0)) > 0 &&
+			g.evm.Time.Cmp(new(big.Int).Add(lastFinedAt, cooldown)) < 0 {
+			return errFinedTooRecently
+		}
+		g.state.PutLastFinedAt(nodeAddr, fineType, g.evm.Time)
+	}
+	g.state.SetFineRecords(hash, true)
+
+	// Set fined value.
+	amount = g.state.addFine(nodeOffset, amount)
+
+	g.state.emitFined(nodeAddr, amount)
+
+	return nil
+}
+
+// slash burns up to amount of the node's stake. Unlike fine, the slashed
+// stake is removed from the contract balance and the total supply.
+func (g *GovernanceContract) slash(nodeAddr common.Address, amount *big.Int, payloads ...[]byte) error {
+	sort.Sort(sortBytes(payloads))
+
+	// Slash records are kept apart from fine records so that a fine on the
+	// same evidence does not prevent the slash.
+	hash := Bytes32(crypto.Keccak256Hash(
+		[]byte("slash"), crypto.Keccak256(payloads...)))
+	if g.state.FineRecords(hash) {
+		return errors.New("already slashed")
+	}
+	g.state.SetFineRecords(hash, true)
+
+	nodeOffset := g.state.NodesOffsetByAddress(nodeAddr)
+	if nodeOffset.Cmp(big.NewInt(0)) < 0 {
+		return errExecutionReverted
+	}
+
+	node := g.state.Node(nodeOffset)
+	if amount.Cmp(node.Staked) > 0 {
+		amount = node.Staked
+	}
+	node.Staked = new(big.Int).Sub(node.Staked, amount)
+	g.state.UpdateNode(nodeOffset, node)
+
+	if err := g.state.DecTotalStaked(amount); err != nil {
+		return err
+	}
+	if err := g.state.DecTotalSupply(amount); err != nil {
+		return err
+	}
+	g.state.StateDB.SubBalance(GovernanceContractAddress, amount)
+
+	g.state.emitSlashed(nodeAddr, amount)
+
+	// A fully slashed node with nothing left to withdraw or pay is removed.
+	if node.Staked.Cmp(big.NewInt(0)) == 0 &&
+		node.Unstaked.Cmp(big.NewInt(0)) == 0 &&
+		node.Fined.Cmp(big.NewInt(0)) == 0 {
+		g.removeNode(nodeOffset, node)
+	}
+
+	return nil
+}
+
+// verifyDKGComplaint verifies the complaint against the master public key of
+// the accused and returns whether the accused needs to be fined.
+func (g *GovernanceContract) verifyDKGComplaint(dkgComplaint *dkgTypes.Complaint) (bool, error) {
+	verified, _ := coreUtils.VerifyDKGComplaintSignature(dkgComplaint)
+	if !verified {
+		return false, errExecutionReverted
+	}
+
+	mpkOffset := g.state.DKGMasterPublicKeyOffset(Bytes32(dkgComplaint.PrivateShare.ProposerID.Hash))
+	if mpkOffset.Cmp(big.NewInt(0)) < 0 {
+		return false, errExecutionReverted
+	}
+	mpk, err := g.coreDKGUtils.DKGMasterPublicKeyItem(&g.state, mpkOffset)
+	if err != nil {
+		return false, errExecutionReverted
+	}
+
+	// Verify DKG complaint is correct.
+	ok, err := coreUtils.VerifyDKGComplaint(dkgComplaint, mpk)
+	if !ok || err != nil {
+		return false, errExecutionReverted
+	}
+
+	need, err := coreUtils.NeedPenaltyDKGPrivateShare(dkgComplaint, mpk)
+	if err != nil {
+		return false, errExecutionReverted
+	}
+	return need, nil
+}
+
+func (g *GovernanceContract) report(reportType *big.Int, arg1, arg2 []byte) ([]byte, error) {
+	typeEnum := FineType(reportType.Uint64())
 	var reportedNodeID coreTypes.NodeID
 
 	switch typeEnum {
@@ -2019,12 +3715,21 @@ func (g *GovernanceContract) report(reportType *big.Int, arg1, arg2 []byte) ([]b
 		if err := rlp.DecodeBytes(arg2, vote2); err != nil {
 			return nil, errExecutionReverted
 		}
+		// Reject mismatched pairs before paying for signature verification.
+		if vote1.ProposerID != vote2.ProposerID {
+			return revertWithReason("proposer mismatch")
+		}
+		if vote1.Type != vote2.Type || vote1.Period != vote2.Period ||
+			vote1.Position != vote2.Position ||
+			vote1.BlockHash == vote2.BlockHash {
+			return revertWithReason("votes not conflicting")
+		}
 		need, err := coreUtils.NeedPenaltyForkVote(vote1, vote2)
 		if !need || err != nil {
 			return nil, errExecutionReverted
 		}
 		reportedNodeID = vote1.ProposerID
-	case FineTypeForkBlock:
+	case FineTypeForkBlock, FineTypeSlashForkBlock:
 		block1 := new(coreTypes.Block)
 		if err := rlp.DecodeBytes(arg1, block1); err != nil {
 			return nil, errExecutionReverted
@@ -2038,6 +3743,26 @@ func (g *GovernanceContract) report(reportType *big.Int, arg1, arg2 []byte) ([]b
 			return nil, errExecutionReverted
 		}
 		reportedNodeID = block1.ProposerID
+	case FineTypeInvalidDKG:
+		dkgComplaint := new(dkgTypes.Complaint)
+		if err := rlp.DecodeBytes(arg1, dkgComplaint); err != nil {
+			return nil, errExecutionReverted
+		}
+		// Only complaints against the DKG stored in the contract can be
+		// verified.
+		round := new(big.Int).SetUint64(dkgComplaint.Round)
+		if round.Cmp(g.state.DKGRound()) != 0 ||
+			dkgComplaint.Reset != g.state.DKGResetCount(round).Uint64() {
+			return nil, errExecutionReverted
+		}
+		need, err := g.verifyDKGComplaint(dkgComplaint)
+		if !need || err != nil {
+			return nil, errExecutionReverted
+		}
+		reportedNodeID = dkgComplaint.PrivateShare.ProposerID
+		// Share the fine record with addDKGComplaint, which fines with the
+		// complaint alone.
+		arg2 = nil
 	default:
 		return nil, errExecutionReverted
 	}
@@ -2050,12 +3775,70 @@ func (g *GovernanceContract) report(reportType *big.Int, arg1, arg2 []byte) ([]b
 	g.state.emitReported(node.Owner, reportType, arg1, arg2)
 
 	fineValue := g.state.FineValue(reportType)
-	if err := g.fine(node.Owner, fineValue, arg1, arg2); err != nil {
+	if typeEnum == FineTypeSlashForkBlock {
+		// Slashing is disabled unless a value is configured for it.
+		if fineValue.Cmp(big.NewInt(0)) == 0 {
+			return nil, errExecutionReverted
+		}
+		if err := g.slash(node.Owner, fineValue, arg1, arg2); err != nil {
+			return nil, errExecutionReverted
+		}
+		return nil, nil
+	}
+	if err := g.fine(node.Owner, reportType, fineValue, arg1, arg2); err != nil {
+		if err == errFinedTooRecently || err == errFineCapReached {
+			return revertWithReason(err.Error())
+		}
 		return nil, errExecutionReverted
 	}
 	return nil, nil
 }
 
+// reportBatch runs report for each entry and returns the number of entries
+// accepted. Entries that fail are rolled back and skipped, so a single bad
+// entry does not revert the whole batch. Every entry is charged gas for its
+// verification whether it is accepted or not.
+func (g *GovernanceContract) reportBatch(reportTypes []*big.Int, arg1s, arg2s [][]byte) (*big.Int, error) {
+	if len(reportTypes) != len(arg1s) || len(reportTypes) != len(arg2s) {
+		return nil, errExecutionReverted
+	}
+	if len(reportTypes) > maxReportBatchSize {
+		return nil, errExecutionReverted
+	}
+	count := big.NewInt(0)
+	for i := range reportTypes {
+		if _, err := g.useGas(reportBatchEntryGasCost); err != nil {
+			return nil, err
+		}
+		snapshot := g.evm.StateDB.Snapshot()
+		if _, err := g.report(reportTypes[i], arg1s[i], arg2s[i]); err != nil {
+			g.evm.StateDB.RevertToSnapshot(snapshot)
+			continue
+		}
+		count.Add(count, big.NewInt(1))
+	}
+	return count, nil
+}
+
+// dkgSucceeded returns whether the DKG of round recorded in state produces a
+// valid group public key. Missing the threshold is not an error.
+func (g *GovernanceContract) dkgSucceeded(state *GovernanceState, round *big.Int) (bool, error) {
+	gpk, err := g.coreDKGUtils.NewGroupPublicKey(state, round, g.dkgThreshold(round))
+	if gpk, ok := gpk.(*dkgTypes.GroupPublicKey); ok {
+		if len(gpk.QualifyNodeIDs) < coreUtils.GetDKGValidThreshold(&coreTypes.Config{
+			NotarySetSize: uint32(g.configNotarySetSize(round).Uint64())}) {
+			err = dkgTypes.ErrNotReachThreshold
+		}
+	}
+	switch err {
+	case nil:
+		return true, nil
+	case dkgTypes.ErrNotReachThreshold, dkgTypes.ErrInvalidThreshold:
+		return false, nil
+	}
+	return false, err
+}
+
 func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 	round := g.evm.Round
 	nextRound := new(big.Int).Add(round, big.NewInt(1))
@@ -2076,9 +3859,9 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 	}
 
 	// Extend the the current round.
-	// target = (85 + 100 * DKGResetCount)%
+	// target = (DKGResetGracePercent + 100 * DKGResetCount)%
 	target := new(big.Int).Add(
-		big.NewInt(85),
+		g.state.DKGResetGracePercent(),
 		new(big.Int).Mul(big.NewInt(100), resetCount))
 
 	roundHeight := g.state.RoundHeight(round)
@@ -2099,144 +3882,398 @@ func (g *GovernanceContract) resetDKG(newSignedCRS []byte) ([]byte, error) {
 		return nil, errExecutionReverted
 	}
 
-	tsigThreshold := coreUtils.GetDKGThreshold(&coreTypes.Config{
-		NotarySetSize: uint32(g.configNotarySetSize(nextRound).Uint64())})
+	tsigThreshold := g.dkgThreshold(nextRound)
 	// Check if next DKG has not enough of success.
 	if g.state.DKGSuccessesCount().Uint64() >=
 		uint64(coreUtils.GetDKGValidThreshold(&coreTypes.Config{
 			NotarySetSize: uint32(g.configNotarySetSize(nextRound).Uint64()),
 		})) {
 		// Check if next DKG did not success.
-		// Calculate 2f + 1
-		threshold := 2*g.configNotarySetSize(nextRound).Uint64()/3 + 1
-
 		// If 2f + 1 of DKG set is finalized, check if DKG succeeded.
-		if g.state.DKGFinalizedsCount().Uint64() >= threshold {
-			gpk, err := g.coreDKGUtils.NewGroupPublicKey(&g.state, nextRound, tsigThreshold)
-			if gpk, ok := gpk.(*dkgTypes.GroupPublicKey); ok {
-				if len(gpk.QualifyNodeIDs) < coreUtils.GetDKGValidThreshold(&coreTypes.Config{
-					NotarySetSize: uint32(g.configNotarySetSize(nextRound).Uint64())}) {
-					err = dkgTypes.ErrNotReachThreshold
-				}
+		if g.state.DKGFinalizedsCount().Uint64() >= g.dkgByzantineThreshold(nextRound) {
+			succeeded, err := g.dkgSucceeded(&g.state, nextRound)
+			if err != nil || succeeded {
+				return nil, errExecutionReverted
 			}
+		}
+	}
+
+	// Fine fail stop DKGs.
+	g.fineFailStopDKG(tsigThreshold)
+
+	// Update CRS.
+	state, err := getRoundState(g.evm, round)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	prevCRS := state.CRS()
+
+	// CRS(n) = hash(CRS(n-1)) if n <= core.DKGRoundDelay
+	if round.Uint64() == dexCore.DKGDelayRound {
+		for i := uint64(0); i < dexCore.DKGDelayRound; i++ {
+			prevCRS = crypto.Keccak256Hash(prevCRS[:])
+		}
+	}
+
+	for i := uint64(0); i < resetCount.Uint64()+1; i++ {
+		prevCRS = crypto.Keccak256Hash(prevCRS[:])
+	}
+
+	dkgGPK, err := g.coreDKGUtils.NewGroupPublicKey(state, round, g.dkgThreshold(round))
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	signature := coreCrypto.Signature{
+		Type:      "bls",
+		Signature: newSignedCRS,
+	}
+	if !dkgGPK.VerifySignature(coreCommon.Hash(prevCRS), signature) {
+		return nil, errExecutionReverted
+	}
+
+	// Clear DKG states for next round.
+	g.clearDKG()
+	g.state.SetDKGRound(nextRound)
+
+	// Save new CRS into state and increase round.
+	newCRS := crypto.Keccak256(newSignedCRS)
+	crs := common.BytesToHash(newCRS)
+
+	g.state.SetCRS(crs)
+	g.state.SetCRSRound(nextRound)
+	g.state.emitCRSProposed(nextRound, crs)
+
+	// Increase reset count.
+	g.state.IncDKGResetCount(nextRound)
+	g.state.emitDKGReset(round, blockHeight)
+
+	if err := g.assertRoundConsistency(); err != nil {
+		return revertWithReason(err.Error())
+	}
+	return nil, nil
+}
+
+// upgradeMethods are the methods only available once the state is upgraded.
+var upgradeMethods = map[string]bool{
+	"acceptOwnership":          true,
+	"fineCapMultiple":          true,
+	"isOwner":                  true,
+	"pendingOwner":             true,
+	"roundConsistencyCheck":    true,
+	"setFineCapMultiple":       true,
+	"setRoundConsistencyCheck": true,
+	"setUnstakeFee":            true,
+	"unstakeFee":               true,
+}
+
+// Run executes governance contract.
+func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (ret []byte, err error) {
+	if len(input) < 4 {
+		return nil, errExecutionReverted
+	}
 
-			// DKG success.
-			if err == nil {
+	// Initialize contract state.
+	g.evm = evm
+	g.state = GovernanceState{evm.StateDB}
+	g.contract = contract
+
+	// Parse input.
+	method, exists := GovernanceABI.Sig2Method[string(input[:4])]
+	if !exists {
+		return nil, errExecutionReverted
+	}
+
+	arguments := input[4:]
+
+	// Methods introduced by the Dexcon upgrade did not exist before it, so
+	// calls to them revert like calls to unknown methods did.
+	if upgradeMethods[method.Name] && !g.state.Upgraded() {
+		return nil, errExecutionReverted
+	}
+
+	// Dispatch method call.
+	switch method.Name {
+	case "acceptOwnership":
+		return g.acceptOwnership()
+	case "addDKGComplaint":
+		var Complaint []byte
+		if err := method.Inputs.Unpack(&Complaint, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.addDKGComplaint(Complaint)
+	case "addDKGMasterPublicKey":
+		var PublicKey []byte
+		if err := method.Inputs.Unpack(&PublicKey, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.addDKGMasterPublicKey(PublicKey)
+	case "addDKGMPKReady":
+		var MPKReady []byte
+		if err := method.Inputs.Unpack(&MPKReady, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.addDKGMPKReady(MPKReady)
+	case "addDKGFinalize":
+		var Finalize []byte
+		if err := method.Inputs.Unpack(&Finalize, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.addDKGFinalize(Finalize)
+	case "addDKGSuccess":
+		var Success []byte
+		if err := method.Inputs.Unpack(&Success, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.addDKGSuccess(Success)
+	case "claimDKGReward":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.claimDKGReward(round)
+	case "configRoundFor":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(configRound(round))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgComplaintsSummary":
+		// Undecodable complaints are skipped so a single bad entry does not
+		// hide the rest.
+		var (
+			complainers = []Bytes32{}
+			accused     = []Bytes32{}
+		)
+		for _, comp := range g.state.DKGComplaintItems() {
+			complainers = append(complainers, Bytes32(comp.ProposerID.Hash))
+			accused = append(accused, Bytes32(comp.PrivateShare.ProposerID.Hash))
+		}
+		res, err := method.Outputs.Pack(complainers, accused)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgResetCounts":
+		args := struct {
+			FromRound *big.Int
+			ToRound   *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		span := new(big.Int).Sub(args.ToRound, args.FromRound)
+		if span.Sign() < 0 || span.Cmp(big.NewInt(maxDKGResetCountsSpan)) >= 0 {
+			return revertWithReason("invalid round range")
+		}
+		counts := []*big.Int{}
+		for round := new(big.Int).Set(args.FromRound); round.Cmp(args.ToRound) <= 0; round.Add(round, big.NewInt(1)) {
+			counts = append(counts, g.state.DKGResetCount(round))
+		}
+		res, err := method.Outputs.Pack(counts)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgStatus":
+		// Lengths are read directly so the stored items are not decoded.
+		dkgRound := g.state.DKGRound()
+		res, err := method.Outputs.Pack(dkgRound,
+			g.state.LenDKGMasterPublicKeys(), g.state.LenDKGComplaints(),
+			g.state.DKGMPKReadysCount(), g.state.DKGFinalizedsCount(),
+			g.configNotarySetSize(dkgRound))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgSucceeded":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		// The DKG of a past round is kept in the state at the head of that
+		// round, and no DKG has started for rounds after DKGRound.
+		var succeeded bool
+		switch dkgRound := g.state.DKGRound(); round.Cmp(dkgRound) {
+		case 0:
+			var err error
+			if succeeded, err = g.dkgSucceeded(&g.state, round); err != nil {
 				return nil, errExecutionReverted
 			}
-			switch err {
-			case dkgTypes.ErrNotReachThreshold, dkgTypes.ErrInvalidThreshold:
-			default:
+		case -1:
+			state, err := getRoundState(g.evm, round)
+			if err != nil {
 				return nil, errExecutionReverted
 			}
+			if succeeded, err = g.dkgSucceeded(state, round); err != nil {
+				return nil, errExecutionReverted
+			}
+		}
+		res, err := method.Outputs.Pack(succeeded)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgThreshold":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(big.NewInt(int64(g.dkgThreshold(round))))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "effectiveStake":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		offset := g.state.NodesOffsetByAddress(address)
+		if offset.Cmp(big.NewInt(0)) < 0 {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.state.Node(offset).EffectiveStake())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "finedNodes":
+		args := struct {
+			Offset *big.Int
+			Limit  *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.finedNodes(method, args.Offset, args.Limit)
+	case "forceUnstake":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.forceUnstake(address)
+	case "forgiveFine":
+		args := struct {
+			NodeAddress common.Address
+			Amount      *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.forgiveFine(args.NodeAddress, args.Amount)
+	case "isInDKGSet":
+		// DKG set is the notary set of the round.
+		return g.isInSet(method, arguments, g.getNotarySet)
+	case "isInNotarySet":
+		return g.isInSet(method, arguments, g.getNotarySet)
+	case "isOwner":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(address == g.state.Owner())
+		if err != nil {
+			return nil, errExecutionReverted
 		}
-	}
-
-	// Fine fail stop DKGs.
-	g.fineFailStopDKG(tsigThreshold)
-
-	// Update CRS.
-	state, err := getRoundState(g.evm, round)
-	if err != nil {
-		return nil, errExecutionReverted
-	}
-	prevCRS := state.CRS()
-
-	// CRS(n) = hash(CRS(n-1)) if n <= core.DKGRoundDelay
-	if round.Uint64() == dexCore.DKGDelayRound {
-		for i := uint64(0); i < dexCore.DKGDelayRound; i++ {
-			prevCRS = crypto.Keccak256Hash(prevCRS[:])
+		return res, nil
+	case "lockupRemaining":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
+			return nil, errExecutionReverted
 		}
-	}
-
-	for i := uint64(0); i < resetCount.Uint64()+1; i++ {
-		prevCRS = crypto.Keccak256Hash(prevCRS[:])
-	}
-
-	dkgGPK, err := g.coreDKGUtils.NewGroupPublicKey(state, round,
-		coreUtils.GetDKGThreshold(&coreTypes.Config{
-			NotarySetSize: uint32(g.configNotarySetSize(round).Uint64())}))
-	if err != nil {
-		return nil, errExecutionReverted
-	}
-	signature := coreCrypto.Signature{
-		Type:      "bls",
-		Signature: newSignedCRS,
-	}
-	if !dkgGPK.VerifySignature(coreCommon.Hash(prevCRS), signature) {
-		return nil, errExecutionReverted
-	}
-
-	// Clear DKG states for next round.
-	g.clearDKG()
-	g.state.SetDKGRound(nextRound)
-
-	// Save new CRS into state and increase round.
-	newCRS := crypto.Keccak256(newSignedCRS)
-	crs := common.BytesToHash(newCRS)
-
-	g.state.SetCRS(crs)
-	g.state.SetCRSRound(nextRound)
-	g.state.emitCRSProposed(nextRound, crs)
-
-	// Increase reset count.
-	g.state.IncDKGResetCount(nextRound)
-	g.state.emitDKGReset(round, blockHeight)
-
-	return nil, nil
-}
-
-// Run executes governance contract.
-func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (ret []byte, err error) {
-	if len(input) < 4 {
-		return nil, errExecutionReverted
-	}
-
-	// Initialize contract state.
-	g.evm = evm
-	g.state = GovernanceState{evm.StateDB}
-	g.contract = contract
-
-	// Parse input.
-	method, exists := GovernanceABI.Sig2Method[string(input[:4])]
-	if !exists {
-		return nil, errExecutionReverted
-	}
-
-	arguments := input[4:]
-
-	// Dispatch method call.
-	switch method.Name {
-	case "addDKGComplaint":
-		var Complaint []byte
-		if err := method.Inputs.Unpack(&Complaint, arguments); err != nil {
+		res, err := method.Outputs.Pack(g.lockupRemaining(address))
+		if err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.addDKGComplaint(Complaint)
-	case "addDKGMasterPublicKey":
-		var PublicKey []byte
-		if err := method.Inputs.Unpack(&PublicKey, arguments); err != nil {
+		return res, nil
+	case "miningState":
+		// MiningHalved only runs while finalizing a block, so all values read
+		// within a call belong to the same halving period.
+		res, err := method.Outputs.Pack(g.state.MiningVelocity(),
+			g.state.NextHalvingSupply(), g.state.LastHalvedAmount(), g.state.TotalSupply())
+		if err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.addDKGMasterPublicKey(PublicKey)
-	case "addDKGMPKReady":
-		var MPKReady []byte
-		if err := method.Inputs.Unpack(&MPKReady, arguments); err != nil {
+		return res, nil
+	case "nodeByAddress":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.addDKGMPKReady(MPKReady)
-	case "addDKGFinalize":
-		var Finalize []byte
-		if err := method.Inputs.Unpack(&Finalize, arguments); err != nil {
+		return g.nodeByAddress(method, &g.state, address)
+	case "nodeExists":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.addDKGFinalize(Finalize)
-	case "addDKGSuccess":
-		var Success []byte
-		if err := method.Inputs.Unpack(&Success, arguments); err != nil {
+		res, err := method.Outputs.Pack(g.state.NodesOffsetByAddress(address).Sign() >= 0)
+		if err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.addDKGSuccess(Success)
+		return res, nil
+	case "nodeExistsByNodeKeyAddress":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.state.NodesOffsetByNodeKeyAddress(address).Sign() >= 0)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "nodesByNodeKeyAddress":
+		args := struct {
+			Round          *big.Int
+			NodeKeyAddress common.Address
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, args.Round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		offset := state.NodesOffsetByNodeKeyAddress(args.NodeKeyAddress)
+		if offset.Cmp(big.NewInt(0)) < 0 {
+			return nil, errExecutionReverted
+		}
+		info := state.Node(offset)
+		res, err := method.Outputs.Pack(
+			info.Owner, info.PublicKey, info.Staked, info.Fined,
+			info.Name, info.Email, info.Location, info.Url,
+			info.Unstaked, info.UnstakedAt)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "nodesPaginated":
+		args := struct {
+			Round  *big.Int
+			Offset *big.Int
+			Limit  *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, args.Round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.nodesPaginated(method, state, args.Offset, args.Limit)
+	case "notarySet":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.notarySetNodeKeyAddresses(round))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "nodesLength":
 		res, err := method.Outputs.Pack(g.state.LenNodes())
 		if err != nil {
@@ -2258,6 +4295,29 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return g.proposeCRS(args.Round, args.SignedCRS)
+	case "qualifiedNodes":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.qualifiedNodeKeyAddresses(state))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "reconcile":
+		// For auditing that the contract holds at least totalStaked,
+		// totalUnstaked and awardPool combined.
+		res, err := method.Outputs.Pack(g.evm.StateDB.GetBalance(GovernanceContractAddress),
+			g.state.TotalStaked(), g.state.TotalFined(), g.state.AwardPool(), g.state.TotalUnstaked())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "report":
 		args := struct {
 			Type *big.Int
@@ -2268,6 +4328,24 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return g.report(args.Type, args.Arg1, args.Arg2)
+	case "reportBatch":
+		args := struct {
+			Types []*big.Int
+			Arg1s [][]byte
+			Arg2s [][]byte
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		count, err := g.reportBatch(args.Types, args.Arg1s, args.Arg2s)
+		if err != nil {
+			return nil, err
+		}
+		res, err := method.Outputs.Pack(count)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "resetDKG":
 		args := struct {
 			NewSignedCRS []byte
@@ -2287,9 +4365,203 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 		if err := method.Inputs.Unpack(&args, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.register(args.PublicKey, args.Name, args.Email, args.Location, args.Url)
-	case "stake":
-		return g.stake()
+		return g.nonReentrant(func() ([]byte, error) {
+			return g.register(args.PublicKey, args.Name, args.Email, args.Location, args.Url)
+		})
+	case "registerWithSignature":
+		args := struct {
+			PublicKey []byte
+			Name      string
+			Email     string
+			Location  string
+			Url       string
+			Sig       []byte
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.nonReentrant(func() ([]byte, error) {
+			return g.registerWithSignature(
+				args.PublicKey, args.Name, args.Email, args.Location, args.Url, args.Sig)
+		})
+	case "renounceOwnership":
+		return g.renounceOwnership()
+	case "rotateNodeKey":
+		args := struct {
+			NewPublicKey []byte
+			Sig          []byte
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.rotateNodeKey(args.NewPublicKey, args.Sig)
+	case "roundHeights":
+		args := struct {
+			FromRound *big.Int
+			ToRound   *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		span := new(big.Int).Sub(args.ToRound, args.FromRound)
+		if span.Sign() < 0 || span.Cmp(big.NewInt(maxRoundHeightsSpan)) >= 0 ||
+			args.ToRound.Cmp(g.state.LenRoundHeight()) >= 0 {
+			return revertWithReason("invalid round range")
+		}
+		heights := []*big.Int{}
+		for round := new(big.Int).Set(args.FromRound); round.Cmp(args.ToRound) <= 0; round.Add(round, big.NewInt(1)) {
+			heights = append(heights, g.state.RoundHeight(round))
+		}
+		res, err := method.Outputs.Pack(heights)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "setAutoGasPrice":
+		var enabled bool
+		if err := method.Inputs.Unpack(&enabled, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setAutoGasPrice(enabled)
+	case "setBlockGasLimit":
+		value := new(big.Int)
+		if err := method.Inputs.Unpack(&value, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setConfigValue(value, g.state.SetBlockGasLimit)
+	case "setCRSProposerReward":
+		reward := new(big.Int)
+		if err := method.Inputs.Unpack(&reward, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setCRSProposerReward(reward)
+	case "setDKGComplaintGas":
+		gas := new(big.Int)
+		if err := method.Inputs.Unpack(&gas, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setDKGComplaintGas(gas)
+	case "setDKGResetGracePercent":
+		percent := new(big.Int)
+		if err := method.Inputs.Unpack(&percent, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setDKGResetGracePercent(percent)
+	case "setDKGReward":
+		reward := new(big.Int)
+		if err := method.Inputs.Unpack(&reward, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setDKGReward(reward)
+	case "setFineCapMultiple":
+		multiple := new(big.Int)
+		if err := method.Inputs.Unpack(&multiple, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setFineCapMultiple(multiple)
+	case "setMaxDKGComplaints":
+		limit := new(big.Int)
+		if err := method.Inputs.Unpack(&limit, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setMaxDKGComplaints(limit)
+	case "setMinGasPrice":
+		value := new(big.Int)
+		if err := method.Inputs.Unpack(&value, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setConfigValue(value, g.state.SetMinGasPrice)
+	case "setMinGasPriceBounds":
+		args := struct {
+			Min *big.Int
+			Max *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setMinGasPriceBounds(args.Min, args.Max)
+	case "setPaused":
+		var paused bool
+		if err := method.Inputs.Unpack(&paused, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setPaused(paused)
+	case "setReportCooldown":
+		cooldown := new(big.Int)
+		if err := method.Inputs.Unpack(&cooldown, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setReportCooldown(cooldown)
+	case "setRoundConsistencyCheck":
+		var enabled bool
+		if err := method.Inputs.Unpack(&enabled, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setRoundConsistencyCheck(enabled)
+	case "setRoundLength":
+		value := new(big.Int)
+		if err := method.Inputs.Unpack(&value, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setConfigValue(value, g.state.SetRoundLength)
+	case "setTreasury":
+		var treasury common.Address
+		if err := method.Inputs.Unpack(&treasury, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setTreasury(treasury)
+	case "setUnstakeFee":
+		fee := new(big.Int)
+		if err := method.Inputs.Unpack(&fee, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.setUnstakeFee(fee)
+	case "stake":
+		return g.nonReentrant(g.stake)
+	case "stakeAmount":
+		amount := new(big.Int)
+		if err := method.Inputs.Unpack(&amount, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.nonReentrant(func() ([]byte, error) {
+			return g.stakeAmount(amount)
+		})
+	case "stakeTotal":
+		address := common.Address{}
+		if err := method.Inputs.Unpack(&address, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		// Active stake plus pending withdrawal, zero for unknown nodes.
+		total := big.NewInt(0)
+		offset := g.state.NodesOffsetByAddress(address)
+		if offset.Cmp(big.NewInt(0)) >= 0 {
+			node := g.state.Node(offset)
+			total.Add(node.Staked, node.Unstaked)
+		}
+		res, err := method.Outputs.Pack(total)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "sweepTreasury":
+		amount := new(big.Int)
+		if err := method.Inputs.Unpack(&amount, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.sweepTreasury(amount)
+	case "totalWithdrawable":
+		var addrs []common.Address
+		if err := method.Inputs.Unpack(&addrs, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		if len(addrs) > maxNodesPageSize {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.totalWithdrawable(addrs))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "transferOwnership":
 		var newOwner common.Address
 		if err := method.Inputs.Unpack(&newOwner, arguments); err != nil {
@@ -2316,15 +4588,32 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 		if err := method.Inputs.Unpack(&amount, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
-		return g.unstake(amount)
+		return g.nonReentrant(func() ([]byte, error) {
+			return g.unstake(amount)
+		})
 	case "updateConfiguration":
 		var cfg rawConfigStruct
 		if err := method.Inputs.Unpack(&cfg, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
 		return g.updateConfiguration(&cfg)
+	case "validateBlockInterval":
+		args := struct {
+			ParentTime *big.Int
+			BlockTime  *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		// Timestamps are in the same unit as minBlockInterval (milliseconds).
+		interval := new(big.Int).Sub(args.BlockTime, args.ParentTime)
+		res, err := method.Outputs.Pack(interval.Cmp(g.state.MinBlockInterval()) >= 0)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "withdraw":
-		return g.withdraw()
+		return g.nonReentrant(g.withdraw)
 	case "withdrawable":
 		res, err := method.Outputs.Pack(g.withdrawable())
 		if err != nil {
@@ -2336,6 +4625,18 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 	// Solidity auto generated methods.
 	// --------------------------------
 
+	case "awardPoolBalance":
+		res, err := method.Outputs.Pack(g.state.AwardPool())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "autoGasPrice":
+		res, err := method.Outputs.Pack(g.state.AutoGasPrice())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "blockGasLimit":
 		res, err := method.Outputs.Pack(g.state.BlockGasLimit())
 		if err != nil {
@@ -2348,12 +4649,24 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "crsProposerReward":
+		res, err := method.Outputs.Pack(g.state.CRSProposerReward())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "crsRound":
 		res, err := method.Outputs.Pack(g.state.CRSRound())
 		if err != nil {
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "dkgComplaintGas":
+		res, err := method.Outputs.Pack(g.state.DKGComplaintGas())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "dkgComplaints":
 		offset := new(big.Int)
 		if err := method.Inputs.Unpack(&offset, arguments); err != nil {
@@ -2462,6 +4775,37 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "dkgResetGracePercent":
+		res, err := method.Outputs.Pack(g.state.DKGResetGracePercent())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgReward":
+		res, err := method.Outputs.Pack(g.state.DKGReward())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgRewardRound":
+		res, err := method.Outputs.Pack(g.state.DKGRewardRound())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "dkgRewarded":
+		args := struct {
+			Round       *big.Int
+			NodeAddress common.Address
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.state.DKGRewarded(args.Round, args.NodeAddress))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "dkgRound":
 		res, err := method.Outputs.Pack(g.state.DKGRound())
 		if err != nil {
@@ -2479,6 +4823,20 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "fineValueByType":
+		var typeName string
+		if err := method.Inputs.Unpack(&typeName, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		fineType, ok := fineTypesByName[typeName]
+		if !ok {
+			return revertWithReason("unknown fine type")
+		}
+		res, err := method.Outputs.Pack(g.state.FineValue(new(big.Int).SetUint64(uint64(fineType))))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "fineValues":
 		index := new(big.Int)
 		if err := method.Inputs.Unpack(&index, arguments); err != nil {
@@ -2490,6 +4848,25 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "fineValuesLength":
+		res, err := method.Outputs.Pack(g.state.LenFineValues())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "lastFinedAt":
+		args := struct {
+			NodeAddress common.Address
+			FineType    *big.Int
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.state.LastFinedAt(args.NodeAddress, args.FineType))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "lambdaBA":
 		res, err := method.Outputs.Pack(g.state.LambdaBA())
 		if err != nil {
@@ -2518,12 +4895,24 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "fineCapMultiple":
+		res, err := method.Outputs.Pack(g.state.FineCapMultiple())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "lockupPeriod":
 		res, err := method.Outputs.Pack(g.state.LockupPeriod())
 		if err != nil {
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "maxDKGComplaints":
+		res, err := method.Outputs.Pack(g.maxDKGComplaints(g.state.DKGRound()))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "minBlockInterval":
 		res, err := method.Outputs.Pack(g.state.MinBlockInterval())
 		if err != nil {
@@ -2536,6 +4925,12 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "minGasPriceBounds":
+		res, err := method.Outputs.Pack(g.state.MinGasPriceBounds())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "miningVelocity":
 		res, err := method.Outputs.Pack(g.state.MiningVelocity())
 		if err != nil {
@@ -2559,6 +4954,9 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 		if err := method.Inputs.Unpack(&index, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
+		if index.Cmp(g.state.LenNodes()) >= 0 {
+			return nil, errExecutionReverted
+		}
 		info := g.state.Node(index)
 		res, err := method.Outputs.Pack(
 			info.Owner, info.PublicKey, info.Staked, info.Fined,
@@ -2612,12 +5010,66 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "participationCount":
+		addr := common.Address{}
+		if err := method.Inputs.Unpack(&addr, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(g.state.ParticipationCount(addr))
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "paused":
+		res, err := method.Outputs.Pack(g.state.Paused())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "pendingOwner":
+		res, err := method.Outputs.Pack(g.state.PendingOwner())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "replaceNodePublicKey":
 		var pk []byte
 		if err := method.Inputs.Unpack(&pk, arguments); err != nil {
 			return nil, errExecutionReverted
 		}
 		return g.replaceNodePublicKey(pk)
+	case "qualifiedNodesCount":
+		res, err := method.Outputs.Pack(g.state.QualifiedNodesCount())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "reportCooldown":
+		res, err := method.Outputs.Pack(g.state.ReportCooldown())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "roundConsistencyCheck":
+		res, err := method.Outputs.Pack(g.state.RoundConsistencyCheck())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "roundCRS":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		crs, err := g.roundCRS(round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(crs)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "roundHeight":
 		round := new(big.Int)
 		if err := method.Inputs.Unpack(&round, arguments); err != nil {
@@ -2628,12 +5080,107 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "roundHeightsLength":
+		res, err := method.Outputs.Pack(g.state.LenRoundHeight())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "roundLength":
 		res, err := method.Outputs.Pack(g.state.RoundLength())
 		if err != nil {
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "roundLockupPeriod":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(state.LockupPeriod())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "roundMinGasPrice":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(state.MinGasPrice())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "roundMinStake":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(state.MinStake())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "roundNodeByAddress":
+		args := struct {
+			Round       *big.Int
+			NodeAddress common.Address
+		}{}
+		if err := method.Inputs.Unpack(&args, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		state, err := getConfigState(g.evm, args.Round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return g.nodeByAddress(method, state, args.NodeAddress)
+	case "roundProgress":
+		// RoundHeight of round 0 is zero, so the genesis round reports a zero start.
+		gs, err := getConfigState(g.evm, g.evm.Round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(
+			g.state.RoundHeight(g.evm.Round), g.evm.Context.BlockNumber, gs.RoundLength())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "roundTotalStaked":
+		round := new(big.Int)
+		if err := method.Inputs.Unpack(&round, arguments); err != nil {
+			return nil, errExecutionReverted
+		}
+		// Use the state at the head of the round rather than the config state,
+		// so the value reflects the stake at the start of that round.
+		state, err := getRoundState(g.evm, round)
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		res, err := method.Outputs.Pack(state.TotalStaked())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "totalFined":
+		res, err := method.Outputs.Pack(g.state.TotalFined())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	case "totalStaked":
 		res, err := method.Outputs.Pack(g.state.TotalStaked())
 		if err != nil {
@@ -2646,10 +5193,125 @@ func (g *GovernanceContract) Run(evm *EVM, input []byte, contract *Contract) (re
 			return nil, errExecutionReverted
 		}
 		return res, nil
+	case "totalUnstaked":
+		res, err := method.Outputs.Pack(g.state.TotalUnstaked())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "treasury":
+		res, err := method.Outputs.Pack(g.state.Treasury())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
+	case "unstakeFee":
+		res, err := method.Outputs.Pack(g.state.UnstakeFee())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		return res, nil
 	}
 	return nil, errExecutionReverted
 }
 
+// nodeByAddress packs the node owned by address in state together with its
+// offset, reverting if there is no such node.
+func (g *GovernanceContract) nodeByAddress(
+	method abi.Method, state *GovernanceState, address common.Address) ([]byte, error) {
+	offset := state.NodesOffsetByAddress(address)
+	if offset.Sign() < 0 {
+		return nil, errExecutionReverted
+	}
+	info := state.Node(offset)
+	res, err := method.Outputs.Pack(
+		info.Owner, info.PublicKey, info.Staked, info.Fined,
+		info.Name, info.Email, info.Location, info.Url,
+		info.Unstaked, info.UnstakedAt, offset)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	return res, nil
+}
+
+func (g *GovernanceContract) nodesPaginated(
+	method abi.Method, state *GovernanceState, offset, limit *big.Int) ([]byte, error) {
+	if limit.Cmp(big.NewInt(maxNodesPageSize)) > 0 {
+		limit = big.NewInt(maxNodesPageSize)
+	}
+
+	end := new(big.Int).Add(offset, limit)
+	if length := state.LenNodes(); end.Cmp(length) > 0 {
+		end = length
+	}
+
+	var (
+		owners     = []common.Address{}
+		publicKeys = [][]byte{}
+		staked     = []*big.Int{}
+		fined      = []*big.Int{}
+		names      = []string{}
+		emails     = []string{}
+		locations  = []string{}
+		urls       = []string{}
+		unstaked   = []*big.Int{}
+		unstakedAt = []*big.Int{}
+	)
+	for i := new(big.Int).Set(offset); i.Cmp(end) < 0; i.Add(i, big.NewInt(1)) {
+		node := state.Node(i)
+		owners = append(owners, node.Owner)
+		publicKeys = append(publicKeys, node.PublicKey)
+		staked = append(staked, node.Staked)
+		fined = append(fined, node.Fined)
+		names = append(names, node.Name)
+		emails = append(emails, node.Email)
+		locations = append(locations, node.Location)
+		urls = append(urls, node.Url)
+		unstaked = append(unstaked, node.Unstaked)
+		unstakedAt = append(unstakedAt, node.UnstakedAt)
+	}
+
+	res, err := method.Outputs.Pack(owners, publicKeys, staked, fined,
+		names, emails, locations, urls, unstaked, unstakedAt)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	return res, nil
+}
+
+// finedNodes scans nodes in [offset, offset+limit) and returns the owners and
+// fines of those with an outstanding fine. The scan window is bounded like
+// nodesPaginated, so a page may hold fewer entries than limit.
+func (g *GovernanceContract) finedNodes(
+	method abi.Method, offset, limit *big.Int) ([]byte, error) {
+	if limit.Cmp(big.NewInt(maxNodesPageSize)) > 0 {
+		limit = big.NewInt(maxNodesPageSize)
+	}
+
+	end := new(big.Int).Add(offset, limit)
+	if length := g.state.LenNodes(); end.Cmp(length) > 0 {
+		end = length
+	}
+
+	var (
+		owners = []common.Address{}
+		fined  = []*big.Int{}
+	)
+	for i := new(big.Int).Set(offset); i.Cmp(end) < 0; i.Add(i, big.NewInt(1)) {
+		node := g.state.Node(i)
+		if node.Fined.Cmp(big.NewInt(0)) > 0 {
+			owners = append(owners, node.Owner)
+			fined = append(fined, node.Fined)
+		}
+	}
+
+	res, err := method.Outputs.Pack(owners, fined)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	return res, nil
+}
+
 func (g *GovernanceContract) transferOwnership(newOwner common.Address) ([]byte, error) {
 	// Only owner can update configuration.
 	if g.contract.Caller() != g.state.Owner() {
@@ -2658,7 +5320,38 @@ func (g *GovernanceContract) transferOwnership(newOwner common.Address) ([]byte,
 	if newOwner == (common.Address{}) {
 		return nil, errExecutionReverted
 	}
-	g.state.SetOwner(newOwner)
+	if !g.state.Upgraded() {
+		g.state.SetOwner(newOwner)
+		return nil, nil
+	}
+	// Ownership only moves when the new owner accepts it.
+	g.state.SetPendingOwner(newOwner)
+	g.state.emitOwnershipTransferStarted(g.state.Owner(), newOwner)
+	return nil, nil
+}
+
+// renounceOwnership permanently leaves the contract without an owner, so
+// owner-only methods can no longer be called.
+func (g *GovernanceContract) renounceOwnership() ([]byte, error) {
+	owner := g.state.Owner()
+	if g.contract.Caller() != owner {
+		return nil, errExecutionReverted
+	}
+	g.state.SetOwner(common.Address{})
+	g.state.SetPendingOwner(common.Address{})
+	g.state.emitOwnershipTransferred(owner, common.Address{})
+	return nil, nil
+}
+
+func (g *GovernanceContract) acceptOwnership() ([]byte, error) {
+	caller := g.contract.Caller()
+	if caller == (common.Address{}) || caller != g.state.PendingOwner() {
+		return nil, errExecutionReverted
+	}
+	previousOwner := g.state.Owner()
+	g.state.SetOwner(caller)
+	g.state.SetPendingOwner(common.Address{})
+	g.state.emitOwnershipTransferred(previousOwner, caller)
 	return nil, nil
 }
 
@@ -2732,10 +5425,22 @@ func (g *GovernanceContract) replaceNodePublicKey(newPublicKey []byte) ([]byte,
 
 	node := g.state.Node(offset)
 
-	_, err := publicKeyToNodeKeyAddress(newPublicKey)
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(newPublicKey)
 	if err != nil {
 		return nil, errExecutionReverted
 	}
+	if g.state.Upgraded() {
+		if _, err := ecdsa.NewPublicKeyFromByteSlice(newPublicKey); err != nil {
+			return nil, errExecutionReverted
+		}
+	}
+
+	// Taking over the key of another node would overwrite its node key
+	// offset.
+	if keyOffset := g.state.NodesOffsetByNodeKeyAddress(nodeKeyAddr); keyOffset.Cmp(big.NewInt(0)) >= 0 &&
+		keyOffset.Cmp(offset) != 0 {
+		return revertWithReason("duplicated public key")
+	}
 
 	g.state.DeleteNodeOffsets(node)
 
@@ -2748,6 +5453,19 @@ func (g *GovernanceContract) replaceNodePublicKey(newPublicKey []byte) ([]byte,
 	return nil, nil
 }
 
+// rotateNodeKey replaces the public key of the caller's node like
+// replaceNodePublicKey, but requires sig, a signature by the new key binding it
+// to the caller address, see NodeKeySignatureHash.
+func (g *GovernanceContract) rotateNodeKey(newPublicKey, sig []byte) ([]byte, error) {
+	if _, err := publicKeyToNodeKeyAddress(newPublicKey); err != nil {
+		return revertWithReason("invalid public key")
+	}
+	if !g.verifyNodeKeySignature(NodeKeySignatureRotate, newPublicKey, sig) {
+		return revertWithReason("invalid signature")
+	}
+	return g.replaceNodePublicKey(newPublicKey)
+}
+
 func PackProposeCRS(round uint64, signedCRS []byte) ([]byte, error) {
 	method := GovernanceABI.Name2Method["proposeCRS"]
 	res, err := method.Inputs.Pack(big.NewInt(int64(round)), signedCRS)
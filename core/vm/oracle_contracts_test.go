@@ -20,6 +20,7 @@ package vm
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"errors"
 	"math/big"
 	"math/rand"
 	"sort"
@@ -29,6 +30,7 @@ import (
 	coreCommon "github.com/dexon-foundation/dexon-consensus/common"
 	dexCore "github.com/dexon-foundation/dexon-consensus/core"
 	coreCrypto "github.com/dexon-foundation/dexon-consensus/core/crypto"
+	cryptoDKG "github.com/dexon-foundation/dexon-consensus/core/crypto/dkg"
 	coreEcdsa "github.com/dexon-foundation/dexon-consensus/core/crypto/ecdsa"
 	coreTypes "github.com/dexon-foundation/dexon-consensus/core/types"
 	dkgTypes "github.com/dexon-foundation/dexon-consensus/core/types/dkg"
@@ -117,6 +119,30 @@ func (g *GovernanceStateTestSuite) TestReadWriteEraseBytes() {
 	}
 }
 
+func (g *GovernanceStateTestSuite) TestReadWriteBytesBoundary() {
+	for _, length := range []int32{1, 30, 31, 32, 33, 64} {
+		loc := big.NewInt(rand.Int63())
+		data := randomBytes(length, length)
+		g.s.writeBytes(loc, data)
+		g.Require().Equal(data, g.s.readBytes(loc), "length %d", length)
+
+		// Leading zero bytes must survive the round trip.
+		data[0] = 0
+		g.s.writeBytes(loc, data)
+		g.Require().Equal(data, g.s.readBytes(loc), "length %d", length)
+
+		g.s.eraseBytes(loc)
+		g.Require().Len(g.s.readBytes(loc), 0)
+	}
+
+	// 31 bytes are stored inline, 32 bytes go to the long form layout.
+	loc := big.NewInt(rand.Int63())
+	g.s.writeBytes(loc, randomBytes(31, 31))
+	g.Require().Equal(uint64(62), g.s.getStateBigInt(loc).Uint64()&0xff)
+	g.s.writeBytes(loc, randomBytes(32, 32))
+	g.Require().Equal(uint64(65), g.s.getStateBigInt(loc).Uint64())
+}
+
 func (g *GovernanceStateTestSuite) TestReadWriteErase1DArray() {
 	emptyOffset := 100
 	for j := 0; j < 50; j++ {
@@ -157,6 +183,216 @@ func (g *GovernanceStateTestSuite) TestDisqualify() {
 	g.Require().Error(g.s.Disqualify(node))
 }
 
+func (g *GovernanceStateTestSuite) TestEraseNode() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+	url := "https://dexon.org/" + string(randomBytes(40, 40))
+
+	g.s.Register(addr, pk, "Test", "test@dexon.org", "Taipei", url, g.s.MinStake())
+	g.s.PopLastNode()
+	g.Require().Equal(0, int(g.s.LenNodes().Uint64()))
+
+	elementBaseLoc := g.s.getSlotLoc(big.NewInt(nodesLoc))
+	for i := int64(0); i < nodeStructSize; i++ {
+		loc := new(big.Int).Add(elementBaseLoc, big.NewInt(i))
+		g.Require().Equal(common.Hash{}, g.s.getState(common.BigToHash(loc)))
+	}
+	for _, i := range []int64{1, 4, 5, 6, 7} {
+		loc := new(big.Int).Add(elementBaseLoc, big.NewInt(i))
+		g.Require().Len(g.s.readBytes(loc), 0)
+	}
+
+	// Data chunks of long fields are cleared as well.
+	for _, i := range []int64{1, 7} {
+		dataLoc := g.s.getSlotLoc(new(big.Int).Add(elementBaseLoc, big.NewInt(i)))
+		for j := int64(0); j < 3; j++ {
+			loc := new(big.Int).Add(dataLoc, big.NewInt(j))
+			g.Require().Equal(common.Hash{}, g.s.getState(common.BigToHash(loc)))
+		}
+	}
+}
+
+func (g *GovernanceStateTestSuite) TestMalformedDKGItems() {
+	g.s.PushDKGMasterPublicKey([]byte{0xde, 0xad})
+	g.s.PushDKGComplaint([]byte{0xbe, 0xef})
+
+	// Malformed entries are skipped instead of panicking.
+	g.Require().Len(g.s.DKGMasterPublicKeyItems(), 0)
+	g.Require().Len(g.s.DKGComplaintItems(), 0)
+
+	_, err := g.s.DKGMasterPublicKeyItem(big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *GovernanceStateTestSuite) TestCorruptedArrayLength() {
+	corrupted := new(big.Int).Lsh(big.NewInt(1), 255)
+
+	g.s.setStateBigInt(big.NewInt(dkgComplaintsLoc), corrupted)
+	g.Require().Len(g.s.DKGComplaints(), maxArrayLength)
+	g.s.ClearDKGComplaints()
+	g.Require().Len(g.s.DKGComplaints(), 0)
+
+	g.s.setStateBigInt(big.NewInt(fineValuesLoc), corrupted)
+	g.Require().Len(g.s.FineValues(), maxArrayLength)
+
+	g.s.setStateBigInt(big.NewInt(nodesLoc), corrupted)
+	g.Require().Len(g.s.Nodes(), maxArrayLength)
+}
+
+func (g *GovernanceStateTestSuite) TestAdjustMinGasPrice() {
+	gasLimit := big.NewInt(8000000)
+	g.s.SetBlockGasLimit(gasLimit)
+	g.s.SetMinGasPrice(big.NewInt(1000))
+	g.s.SetMinGasPriceBounds(big.NewInt(500), big.NewInt(1500))
+
+	half := new(big.Int).Div(gasLimit, big.NewInt(2))
+	round := func(blockGasUsed ...*big.Int) {
+		for _, gasUsed := range blockGasUsed {
+			g.s.AddRoundGasUsed(gasUsed)
+		}
+		g.s.AdjustMinGasPrice()
+		gasUsed, count := g.s.RoundGasUsed()
+		g.Require().Equal(0, gasUsed.Sign())
+		g.Require().Equal(0, count.Sign())
+	}
+
+	// The price is fixed while auto adjustment is off.
+	round(gasLimit, gasLimit)
+	g.Require().Equal(int64(1000), g.s.MinGasPrice().Int64())
+
+	g.s.SetAutoGasPrice(true)
+
+	// Half full rounds keep the price, whatever the mix of blocks.
+	round(half, half)
+	g.Require().Equal(int64(1000), g.s.MinGasPrice().Int64())
+	round(gasLimit, big.NewInt(0))
+	g.Require().Equal(int64(1000), g.s.MinGasPrice().Int64())
+
+	// A round without blocks keeps the price.
+	round()
+	g.Require().Equal(int64(1000), g.s.MinGasPrice().Int64())
+
+	// Full rounds raise the price by 1/8 per round, not per block, up to the
+	// upper bound.
+	round(gasLimit, gasLimit, gasLimit)
+	g.Require().Equal(int64(1125), g.s.MinGasPrice().Int64())
+	for i := 0; i < 10; i++ {
+		round(gasLimit, gasLimit)
+		g.Require().True(g.s.MinGasPrice().Cmp(big.NewInt(1500)) <= 0)
+	}
+	g.Require().Equal(int64(1500), g.s.MinGasPrice().Int64())
+
+	// Empty rounds lower the price by 1/8 down to the lower bound.
+	round(big.NewInt(0), big.NewInt(0))
+	g.Require().Equal(int64(1313), g.s.MinGasPrice().Int64())
+	for i := 0; i < 10; i++ {
+		round(big.NewInt(0))
+		g.Require().True(g.s.MinGasPrice().Cmp(big.NewInt(500)) >= 0)
+	}
+	g.Require().Equal(int64(500), g.s.MinGasPrice().Int64())
+
+	// A tiny price still rises on full rounds.
+	g.s.SetMinGasPriceBounds(big.NewInt(1), big.NewInt(1500))
+	g.s.SetMinGasPrice(big.NewInt(1))
+	round(gasLimit)
+	g.Require().Equal(int64(2), g.s.MinGasPrice().Int64())
+}
+
+func (g *GovernanceStateTestSuite) TestInitializeTwice() {
+	g.Require().True(g.s.Initialized())
+	supply := g.s.TotalSupply()
+
+	config := params.TestnetChainConfig.Dexcon
+	g.Require().Panics(func() {
+		g.s.Initialize(config, new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e7)))
+	})
+	g.Require().Equal(supply.String(), g.s.TotalSupply().String())
+	g.Require().Equal(int64(1), g.s.LenRoundHeight().Int64())
+}
+
+func (g *GovernanceStateTestSuite) TestDecUnderflow() {
+	g.s.IncTotalStaked(big.NewInt(10))
+	g.Require().Error(g.s.DecTotalStaked(big.NewInt(11)))
+	g.Require().Equal(big.NewInt(10).String(), g.s.TotalStaked().String())
+	g.Require().NoError(g.s.DecTotalStaked(big.NewInt(10)))
+	g.Require().Equal(0, g.s.TotalStaked().Sign())
+
+	supply := g.s.TotalSupply()
+	g.Require().Error(g.s.DecTotalSupply(new(big.Int).Add(supply, big.NewInt(1))))
+	g.Require().Equal(supply.String(), g.s.TotalSupply().String())
+	g.Require().NoError(g.s.DecTotalSupply(supply))
+	g.Require().Equal(0, g.s.TotalSupply().Sign())
+}
+
+func (g *GovernanceStateTestSuite) TestDKGMasterPublicKeyCache() {
+	newMPK := func() []byte {
+		_, pubShares := cryptoDKG.NewPrivateKeyShares(1)
+		mpk := &dkgTypes.MasterPublicKey{
+			DKGID:           dkgTypes.NewID(coreTypes.NodeID{Hash: coreCommon.NewRandomHash()}),
+			PublicKeyShares: *pubShares.Move(),
+		}
+		b, err := rlp.EncodeToBytes(mpk)
+		g.Require().NoError(err)
+		return b
+	}
+
+	c := &defaultCoreDKGUtils{}
+	g.s.PushDKGMasterPublicKey(newMPK())
+	g.s.PushDKGMasterPublicKey([]byte{0xde, 0xad})
+	g.Require().Len(c.DKGMasterPublicKeyItems(g.s), 1)
+
+	// Newly appended entries are picked up, offsets stay aligned with storage.
+	g.s.PushDKGMasterPublicKey(newMPK())
+	g.Require().Len(c.DKGMasterPublicKeyItems(g.s), 2)
+	_, err := c.DKGMasterPublicKeyItem(g.s, big.NewInt(1))
+	g.Require().Error(err)
+	mpk, err := c.DKGMasterPublicKeyItem(g.s, big.NewInt(2))
+	g.Require().NoError(err)
+	expected, err := g.s.DKGMasterPublicKeyItem(big.NewInt(2))
+	g.Require().NoError(err)
+	g.Require().Equal(expected.DKGID, mpk.DKGID)
+	_, err = c.DKGMasterPublicKeyItem(g.s, big.NewInt(3))
+	g.Require().Error(err)
+
+	// Entries pushed after a clear are not confused with the purged ones.
+	g.s.ClearDKGMasterPublicKeys()
+	c.Purge()
+	g.s.PushDKGMasterPublicKey(newMPK())
+	mpk, err = c.DKGMasterPublicKeyItem(g.s, big.NewInt(0))
+	g.Require().NoError(err)
+	expected, err = g.s.DKGMasterPublicKeyItem(big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(expected.DKGID, mpk.DKGID)
+}
+
+func (g *GovernanceStateTestSuite) TestMiningVelocityRoundTrip() {
+	velocities := []struct {
+		velocity float32
+		fixed    int64
+	}{
+		{0.1875, 18750000},
+		{0.1, 10000000},
+		{0.18750001, 18750001},
+		{0.12345679, 12345679},
+		{0.9999999, 99999990},
+	}
+
+	// Before the upgrade the velocity is scaled in float32, which drifts.
+	cfg := g.s.Configuration()
+	cfg.MiningVelocity = 0.18750001
+	g.s.UpdateConfiguration(cfg)
+	g.Require().Equal(int64(18750002), g.s.MiningVelocity().Int64())
+
+	g.s.Upgrade()
+	for _, v := range velocities {
+		cfg := g.s.Configuration()
+		cfg.MiningVelocity = v.velocity
+		g.s.UpdateConfiguration(cfg)
+		g.Require().Equal(v.fixed, g.s.MiningVelocity().Int64())
+		g.Require().Equal(v.velocity, g.s.Configuration().MiningVelocity)
+	}
+}
+
 func TestGovernanceState(t *testing.T) {
 	suite.Run(t, new(GovernanceStateTestSuite))
 }
@@ -206,6 +442,9 @@ func (g *OracleContractsTestSuite) SetupTest() {
 	// Governance configuration.
 	g.s.UpdateConfiguration(config)
 
+	// Tests run past the Dexcon upgrade fork unless they downgrade.
+	g.s.Upgrade()
+
 	g.stateDB.Commit(true)
 
 	g.context = Context{
@@ -231,6 +470,7 @@ func (g *OracleContractsTestSuite) SetupTest() {
 			return g.stateDB, nil
 		},
 		BlockNumber: big.NewInt(0),
+		GasPrice:    big.NewInt(1e9),
 	}
 
 }
@@ -243,6 +483,12 @@ func (g *OracleContractsTestSuite) TearDownTest() {
 	}
 }
 
+// downgrade rolls the state back to before the Dexcon upgrade fork, to test
+// the legacy behavior replayed for old blocks.
+func (g *OracleContractsTestSuite) downgrade() {
+	g.s.setStateBigInt(big.NewInt(upgradedLoc), big.NewInt(0))
+}
+
 func (g *OracleContractsTestSuite) call(
 	contractAddr common.Address, caller common.Address, input []byte, value *big.Int) ([]byte, error) {
 
@@ -272,7 +518,156 @@ func (g *OracleContractsTestSuite) TestTransferOwnership() {
 	// Call with owner.
 	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
+	g.Require().Equal(g.config.Owner, g.s.Owner())
+
+	// New owner accepts.
+	input, err = GovernanceABI.ABI.Pack("acceptOwnership")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(addr, g.s.Owner())
+
+	input, err = GovernanceABI.ABI.Pack("isOwner", addr)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var isOwner bool
+	err = GovernanceABI.ABI.Unpack(&isOwner, "isOwner", res)
+	g.Require().NoError(err)
+	g.Require().True(isOwner)
+
+	input, err = GovernanceABI.ABI.Pack("isOwner", g.config.Owner)
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&isOwner, "isOwner", res)
+	g.Require().NoError(err)
+	g.Require().False(isOwner)
+}
+
+func (g *OracleContractsTestSuite) TestTransferOwnershipBeforeUpgrade() {
+	g.downgrade()
+	_, addr := newPrefundAccount(g.stateDB)
+
+	// Ownership moves in one step.
+	input, err := GovernanceABI.ABI.Pack("transferOwnership", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
 	g.Require().Equal(addr, g.s.Owner())
+	g.Require().Equal(common.Address{}, g.s.PendingOwner())
+
+	// Methods of the two-step transfer do not exist yet.
+	for _, name := range []string{"acceptOwnership", "pendingOwner"} {
+		input, err = GovernanceABI.ABI.Pack(name)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Equal(errExecutionReverted, err)
+	}
+	input, err = GovernanceABI.ABI.Pack("isOwner", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Equal(errExecutionReverted, err)
+}
+
+func (g *OracleContractsTestSuite) TestTwoStepOwnershipTransfer() {
+	_, addr1 := newPrefundAccount(g.stateDB)
+	_, addr2 := newPrefundAccount(g.stateDB)
+
+	pendingOwner := func() common.Address {
+		input, err := GovernanceABI.ABI.Pack("pendingOwner")
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr1, input, big.NewInt(0))
+		g.Require().NoError(err)
+		var value common.Address
+		err = GovernanceABI.ABI.Unpack(&value, "pendingOwner", res)
+		g.Require().NoError(err)
+		return value
+	}
+	g.Require().Equal(common.Address{}, pendingOwner())
+
+	input, err := GovernanceABI.ABI.Pack("transferOwnership", addr1)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(addr1, pendingOwner())
+
+	// Re-initiating overwrites the pending owner.
+	input, err = GovernanceABI.ABI.Pack("transferOwnership", addr2)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(addr2, pendingOwner())
+
+	// Acceptance by the wrong address should fail.
+	input, err = GovernanceABI.ABI.Pack("acceptOwnership")
+	g.Require().NoError(err)
+	for _, addr := range []common.Address{addr1, g.config.Owner} {
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Error(err)
+	}
+	g.Require().Equal(g.config.Owner, g.s.Owner())
+
+	_, err = g.call(GovernanceContractAddress, addr2, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(addr2, g.s.Owner())
+	g.Require().Equal(common.Address{}, pendingOwner())
+
+	// Acceptance can not be replayed.
+	_, err = g.call(GovernanceContractAddress, addr2, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestRenounceOwnership() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	// Only owner can renounce.
+	input, err := GovernanceABI.ABI.Pack("renounceOwnership")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Pending transfer is dropped as well.
+	input, err = GovernanceABI.ABI.Pack("transferOwnership", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("renounceOwnership")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(common.Address{}, g.s.Owner())
+	g.Require().Equal(common.Address{}, g.s.PendingOwner())
+
+	input, err = GovernanceABI.ABI.Pack("acceptOwnership")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Owner-only methods revert for everyone.
+	input, err = GovernanceABI.ABI.Pack("updateConfiguration",
+		new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+		big.NewInt(1000),
+		big.NewInt(2e9),
+		big.NewInt(8000000),
+		big.NewInt(250),
+		big.NewInt(2500),
+		big.NewInt(int64(70.5*decimalMultiplier)),
+		big.NewInt(264*decimalMultiplier),
+		big.NewInt(600),
+		big.NewInt(900),
+		[]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)})
+	g.Require().NoError(err)
+	for _, caller := range []common.Address{g.config.Owner, addr} {
+		_, err = g.call(GovernanceContractAddress, caller, input, big.NewInt(0))
+		g.Require().Error(err)
+	}
+
+	input, err = GovernanceABI.ABI.Pack("transferOwnership", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
 }
 
 func (g *OracleContractsTestSuite) TestTransferNodeOwnership() {
@@ -385,116 +780,303 @@ func (g *OracleContractsTestSuite) TestReplaceNodePublicKey() {
 	g.Require().Equal(-1, int(g.s.NodesOffsetByNodeKeyAddress(addr).Int64()))
 	g.Require().Equal(0, int(g.s.NodesOffsetByAddress(addr).Int64()))
 	g.Require().Equal(0, int(g.s.NodesOffsetByNodeKeyAddress(addr2).Int64()))
+
+	// Taking over the key of another node should fail.
+	privKey3, addr3 := newPrefundAccount(g.stateDB)
+	pk3 := crypto.FromECDSAPub(&privKey3.PublicKey)
+	input, err = GovernanceABI.ABI.Pack("register", pk3, "Test3", "test3@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr3, input, amount)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("replaceNodePublicKey", pk2)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr3, input, big.NewInt(0))
+	g.Require().Equal(errExecutionReverted, err)
+	var reason string
+	g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+	g.Require().Equal("duplicated public key", reason)
+	g.Require().Equal(0, int(g.s.NodesOffsetByNodeKeyAddress(addr2).Int64()))
+	g.Require().Equal(1, int(g.s.NodesOffsetByNodeKeyAddress(addr3).Int64()))
+	g.Require().Equal(pk3, g.s.Node(big.NewInt(1)).PublicKey)
 }
 
-func (g *OracleContractsTestSuite) TestStakingMechanism() {
+func (g *OracleContractsTestSuite) TestRotateNodeKey() {
 	privKey, addr := newPrefundAccount(g.stateDB)
 	pk := crypto.FromECDSAPub(&privKey.PublicKey)
 
-	// Register with some stake.
-	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
-	balanceBeforeStake := g.stateDB.GetBalance(addr)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
 	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
 
-	g.Require().Equal(1, int(g.s.LenNodes().Uint64()))
-	g.Require().Equal(0, len(g.s.QualifiedNodes()))
-	g.Require().Equal("Test1", g.s.Node(big.NewInt(0)).Name)
-	g.Require().Equal(amount.String(), g.s.TotalStaked().String())
-
-	// Check balance.
-	g.Require().Equal(new(big.Int).Sub(balanceBeforeStake, amount), g.stateDB.GetBalance(addr))
-	g.Require().Equal(new(big.Int).Add(big.NewInt(1), amount), g.stateDB.GetBalance(GovernanceContractAddress))
-
-	// Registering again should fail.
-	_, err = g.call(GovernanceContractAddress, addr, input, amount)
-	g.Require().Error(err)
-
-	// Duplicate public key should fail
-	_, addrDup := newPrefundAccount(g.stateDB)
-	input, err = GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	// A key used by another node is rejected.
+	privKey2, addr2 := newPrefundAccount(g.stateDB)
+	pk2 := crypto.FromECDSAPub(&privKey2.PublicKey)
+	input, err = GovernanceABI.ABI.Pack("register", pk2, "Test2", "test2@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addrDup, input, amount)
-	g.Require().Error(err)
-
-	// Stake more to qualify.
-	input, err = GovernanceABI.ABI.Pack("stake")
+	_, err = g.call(GovernanceContractAddress, addr2, input, amount)
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	chainID := params.TestChainConfig.ChainID
+	sig, err := crypto.Sign(NodeKeySignatureHash(chainID, NodeKeySignatureRotate, addr), privKey2)
 	g.Require().NoError(err)
-	g.Require().Equal(1, len(g.s.QualifiedNodes()))
-	g.Require().Equal(new(big.Int).Add(amount, amount).String(), g.s.TotalStaked().String())
-
-	// Unstake more then staked should fail.
-	unstakeAmount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e6))
-	input, err = GovernanceABI.ABI.Pack("unstake", unstakeAmount)
+	input, err = GovernanceABI.ABI.Pack("rotateNodeKey", pk2, sig)
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().Error(err)
 
-	// Unstake.
-	input, err = GovernanceABI.ABI.Pack("unstake", amount)
-	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	newKey, err := crypto.GenerateKey()
 	g.Require().NoError(err)
-	g.Require().Equal(0, len(g.s.QualifiedNodes()))
-	g.Require().Equal(amount.String(), g.s.TotalStaked().String())
+	newPK := crypto.FromECDSAPub(&newKey.PublicKey)
+	newAddr := crypto.PubkeyToAddress(newKey.PublicKey)
 
-	var ok bool
-	// Withdraw immediately should fail.
-	input, err = GovernanceABI.ABI.Pack("withdrawable")
-	g.Require().NoError(err)
-	output, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	// Signature by the old key is rejected.
+	sig, err = crypto.Sign(NodeKeySignatureHash(chainID, NodeKeySignatureRotate, addr), privKey)
 	g.Require().NoError(err)
-	GovernanceABI.ABI.Unpack(&ok, "withdrawable", output)
-	g.Require().False(ok)
-	input, err = GovernanceABI.ABI.Pack("withdraw")
+	input, err = GovernanceABI.ABI.Pack("rotateNodeKey", newPK, sig)
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().Error(err)
 
-	// Wait for lockup time than withdraw.
-	input, err = GovernanceABI.ABI.Pack("withdrawable")
-	g.Require().NoError(err)
-	output, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-	GovernanceABI.ABI.Unpack(&ok, "withdrawable", output)
-	g.Require().False(ok)
-	time.Sleep(time.Second * 2)
-	input, err = GovernanceABI.ABI.Pack("withdraw")
+	// Signature for another purpose is rejected.
+	sig, err = crypto.Sign(NodeKeySignatureHash(chainID, NodeKeySignatureRegister, addr), newKey)
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-
-	g.Require().Equal(0, len(g.s.QualifiedNodes()))
-	g.Require().Equal(1, int(g.s.LenNodes().Uint64()))
-
-	// Unstake all to remove node.
-	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	input, err = GovernanceABI.ABI.Pack("rotateNodeKey", newPK, sig)
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
+	g.Require().Error(err)
 
-	time.Sleep(time.Second * 2)
-	input, err = GovernanceABI.ABI.Pack("withdrawable")
+	sig, err = crypto.Sign(NodeKeySignatureHash(chainID, NodeKeySignatureRotate, addr), newKey)
 	g.Require().NoError(err)
-	output, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-	GovernanceABI.ABI.Unpack(&ok, "withdrawable", output)
-	g.Require().True(ok)
-	input, err = GovernanceABI.ABI.Pack("withdraw")
+	input, err = GovernanceABI.ABI.Pack("rotateNodeKey", newPK, sig)
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
 
-	g.Require().Equal(0, len(g.s.QualifiedNodes()))
-	g.Require().Equal(0, int(g.s.LenNodes().Uint64()))
+	g.Require().Equal(-1, int(g.s.NodesOffsetByNodeKeyAddress(addr).Int64()))
+	g.Require().Equal(0, int(g.s.NodesOffsetByNodeKeyAddress(newAddr).Int64()))
+	g.Require().Equal(newPK, g.s.Node(big.NewInt(0)).PublicKey)
 
-	node := g.s.Node(big.NewInt(0))
-	g.Require().Equal(big.NewInt(0).String(), node.Staked.String())
-	g.Require().Equal(big.NewInt(0).String(), g.s.TotalStaked().String())
+	// DKG set membership follows the new key.
+	inDKGSet := func(nodeKeyAddr common.Address) bool {
+		input, err := GovernanceABI.ABI.Pack("isInDKGSet", big.NewInt(0), nodeKeyAddr)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		var in bool
+		err = GovernanceABI.ABI.Unpack(&in, "isInDKGSet", res)
+		g.Require().NoError(err)
+		return in
+	}
+	g.Require().True(inDKGSet(newAddr))
+	g.Require().False(inDKGSet(addr))
+}
+
+func (g *OracleContractsTestSuite) TestRegisterInvalidPublicKey() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	// Truncated key should be rejected at register time.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk[:32], "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().Error(err)
+	g.Require().Equal(0, int(g.s.LenNodes().Uint64()))
+
+	// Replacing with a truncated key should be rejected as well.
+	input, err = GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("replaceNodePublicKey", pk[:32])
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().Equal(pk, g.s.Node(big.NewInt(0)).PublicKey)
+}
+
+func (g *OracleContractsTestSuite) TestRegisterWithSignature() {
+	_, owner := newPrefundAccount(g.stateDB)
+	nodeKey, err := crypto.GenerateKey()
+	g.Require().NoError(err)
+	pk := crypto.FromECDSAPub(&nodeKey.PublicKey)
+	nodeKeyAddr := crypto.PubkeyToAddress(nodeKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+
+	chainID := params.TestChainConfig.ChainID
+	_, other := newPrefundAccount(g.stateDB)
+	invalidHashes := [][]byte{
+		// Signature over a different owner.
+		NodeKeySignatureHash(chainID, NodeKeySignatureRegister, other),
+		// Signature for another network.
+		NodeKeySignatureHash(new(big.Int).Add(chainID, big.NewInt(1)), NodeKeySignatureRegister, owner),
+		// Signature for another purpose.
+		NodeKeySignatureHash(chainID, NodeKeySignatureRotate, owner),
+		// Signature without domain separation.
+		crypto.Keccak256(owner.Bytes()),
+	}
+	for _, hash := range invalidHashes {
+		sig, err := crypto.Sign(hash, nodeKey)
+		g.Require().NoError(err)
+		input, err := GovernanceABI.ABI.Pack("registerWithSignature", pk,
+			"Test1", "test1@dexon.org", "Taipei", "https://dexon.org", sig)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, owner, input, amount)
+		g.Require().Error(err)
+		g.Require().Equal(0, int(g.s.LenNodes().Uint64()))
+	}
+
+	sig, err := crypto.Sign(NodeKeySignatureHash(chainID, NodeKeySignatureRegister, owner), nodeKey)
+	g.Require().NoError(err)
+	input, err := GovernanceABI.ABI.Pack("registerWithSignature", pk,
+		"Test1", "test1@dexon.org", "Taipei", "https://dexon.org", sig)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, owner, input, amount)
+	g.Require().NoError(err)
+
+	g.Require().Equal(1, int(g.s.LenNodes().Uint64()))
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(owner, node.Owner)
+	g.Require().Equal(pk, node.PublicKey)
+	g.Require().Equal(amount.String(), node.Staked.String())
+	g.Require().Equal(0, int(g.s.NodesOffsetByNodeKeyAddress(nodeKeyAddr).Int64()))
+}
+
+func (g *OracleContractsTestSuite) TestRevertReason() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	input, err := GovernanceABI.ABI.Pack("stake")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(1))
+	g.Require().Equal(errExecutionReverted, err)
+	g.Require().Equal([]byte{0x08, 0xc3, 0x79, 0xa0}, res[:4])
+
+	var reason string
+	err = revertReasonArguments.Unpack(&reason, res[4:])
+	g.Require().NoError(err)
+	g.Require().Equal("node not found", reason)
+
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Equal(errExecutionReverted, err)
+	err = revertReasonArguments.Unpack(&reason, res[4:])
+	g.Require().NoError(err)
+	g.Require().Equal("not withdrawable", reason)
+}
+
+func (g *OracleContractsTestSuite) TestStakingMechanism() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	// Register with some stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	balanceBeforeStake := g.stateDB.GetBalance(addr)
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	g.Require().Equal(1, int(g.s.LenNodes().Uint64()))
+	g.Require().Equal(0, len(g.s.QualifiedNodes()))
+	g.Require().Equal("Test1", g.s.Node(big.NewInt(0)).Name)
+	g.Require().Equal(amount.String(), g.s.TotalStaked().String())
+
+	// Check balance.
+	g.Require().Equal(new(big.Int).Sub(balanceBeforeStake, amount), g.stateDB.GetBalance(addr))
+	g.Require().Equal(new(big.Int).Add(big.NewInt(1), amount), g.stateDB.GetBalance(GovernanceContractAddress))
+
+	// Registering again should fail.
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().Error(err)
+
+	// Duplicate public key should fail
+	_, addrDup := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addrDup, input, amount)
+	g.Require().Error(err)
+
+	// Stake more to qualify.
+	input, err = GovernanceABI.ABI.Pack("stake")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+	g.Require().Equal(1, len(g.s.QualifiedNodes()))
+	g.Require().Equal(new(big.Int).Add(amount, amount).String(), g.s.TotalStaked().String())
+
+	// Unstake more then staked should fail.
+	unstakeAmount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e6))
+	input, err = GovernanceABI.ABI.Pack("unstake", unstakeAmount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Unstake.
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(0, len(g.s.QualifiedNodes()))
+	g.Require().Equal(amount.String(), g.s.TotalStaked().String())
+
+	var ok bool
+	// Withdraw immediately should fail.
+	input, err = GovernanceABI.ABI.Pack("withdrawable")
+	g.Require().NoError(err)
+	output, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	GovernanceABI.ABI.Unpack(&ok, "withdrawable", output)
+	g.Require().False(ok)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Wait for lockup time than withdraw.
+	input, err = GovernanceABI.ABI.Pack("withdrawable")
+	g.Require().NoError(err)
+	output, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	GovernanceABI.ABI.Unpack(&ok, "withdrawable", output)
+	g.Require().False(ok)
+	time.Sleep(time.Second * 2)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	g.Require().Equal(0, len(g.s.QualifiedNodes()))
+	g.Require().Equal(1, int(g.s.LenNodes().Uint64()))
+
+	// Unstake all to remove node.
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	time.Sleep(time.Second * 2)
+	input, err = GovernanceABI.ABI.Pack("withdrawable")
+	g.Require().NoError(err)
+	output, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	GovernanceABI.ABI.Unpack(&ok, "withdrawable", output)
+	g.Require().True(ok)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	g.Require().Equal(0, len(g.s.QualifiedNodes()))
+	g.Require().Equal(0, int(g.s.LenNodes().Uint64()))
+
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(big.NewInt(0).String(), node.Staked.String())
+	g.Require().Equal(big.NewInt(0).String(), g.s.TotalStaked().String())
 
 	// Stake 2 nodes, and unstake the first then the second.
 	amount = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
@@ -565,450 +1147,3748 @@ func (g *OracleContractsTestSuite) TestStakingMechanism() {
 	g.Require().Equal(big.NewInt(1), g.stateDB.GetBalance(GovernanceContractAddress))
 }
 
-func (g *OracleContractsTestSuite) TestFine() {
+func (g *OracleContractsTestSuite) TestLockupRemaining() {
 	privKey, addr := newPrefundAccount(g.stateDB)
 	pk := crypto.FromECDSAPub(&privKey.PublicKey)
 
-	// Stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
 	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	ownerStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
-	_, err = g.call(GovernanceContractAddress, addr, input, ownerStaked)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
-	g.Require().Equal(1, len(g.s.QualifiedNodes()))
-	g.Require().Equal(ownerStaked, g.s.Node(big.NewInt(0)).Staked)
 
-	_, finePayer := newPrefundAccount(g.stateDB)
-	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	lockup := struct {
+		Ready      bool
+		UnlockTime *big.Int
+	}{}
+	query := func() {
+		input, err := GovernanceABI.ABI.Pack("lockupRemaining", addr)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		err = GovernanceABI.ABI.Unpack(&lockup, "lockupRemaining", res)
+		g.Require().NoError(err)
+	}
 
-	// Paying to node without fine should fail.
-	input, err = GovernanceABI.ABI.Pack("payFine", addr)
+	// Nothing unstaked.
+	query()
+	g.Require().False(lockup.Ready)
+	g.Require().Equal(0, lockup.UnlockTime.Cmp(big.NewInt(0)))
+
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
-	g.Require().NotNil(err)
 
-	// Fined.
-	offset := g.s.NodesOffsetByAddress(addr)
-	g.Require().True(offset.Cmp(big.NewInt(0)) >= 0)
-	node := g.s.Node(offset)
-	node.Fined = new(big.Int).Set(amount)
-	g.s.UpdateNode(offset, node)
-	node = g.s.Node(offset)
-	g.Require().Equal(0, node.Fined.Cmp(amount))
+	// Still locked.
+	node := g.s.Node(big.NewInt(0))
+	query()
+	g.Require().False(lockup.Ready)
+	g.Require().Equal(0, lockup.UnlockTime.Cmp(
+		new(big.Int).Add(node.UnstakedAt, g.s.LockupPeriod())))
+
+	// Unlocked.
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+	query()
+	g.Require().True(lockup.Ready)
+	g.Require().Equal(0, lockup.UnlockTime.Cmp(
+		new(big.Int).Add(big.NewInt(1), g.s.LockupPeriod())))
+}
 
-	// Not qualified after fined.
-	g.Require().Equal(0, len(g.s.QualifiedNodes()))
+func (g *OracleContractsTestSuite) TestTotalWithdrawable() {
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	var owners []common.Address
+	for i := 0; i < 3; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
 
-	// Cannot unstake before fines are paied.
-	input, err = GovernanceABI.ABI.Pack("unstake", big.NewInt(10))
-	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, finePayer, input, big.NewInt(0))
-	g.Require().NotNil(err)
+		input, err = GovernanceABI.ABI.Pack("unstake", amount)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		owners = append(owners, addr)
+	}
 
-	// Paying more than fine should fail.
-	payAmount := new(big.Int).Add(amount, amount)
-	input, err = GovernanceABI.ABI.Pack("payFine", addr)
-	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, finePayer, input, payAmount)
-	g.Require().NotNil(err)
+	// Unlock all but the last node.
+	for i := int64(0); i < 2; i++ {
+		node := g.s.Node(big.NewInt(i))
+		node.UnstakedAt = big.NewInt(1)
+		g.s.UpdateNode(big.NewInt(i), node)
+	}
 
-	// Pay the fine.
-	input, err = GovernanceABI.ABI.Pack("payFine", addr)
+	total := func(addrs []common.Address) (*big.Int, error) {
+		input, err := GovernanceABI.ABI.Pack("totalWithdrawable", addrs)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, owners[0], input, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		value := new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&value, "totalWithdrawable", res)
+		g.Require().NoError(err)
+		return value, nil
+	}
+
+	// Locked, unknown and duplicated addresses are not counted twice.
+	_, unknown := newPrefundAccount(g.stateDB)
+	value, err := total(append(owners, owners[1], unknown))
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
+	g.Require().Equal(new(big.Int).Mul(amount, big.NewInt(2)).String(), value.String())
+
+	value, err = total(nil)
 	g.Require().NoError(err)
+	g.Require().Equal(0, value.Sign())
 
-	// Qualified.
-	g.Require().Equal(1, len(g.s.QualifiedNodes()))
+	// Too many addresses.
+	_, err = total(make([]common.Address, maxNodesPageSize+1))
+	g.Require().Error(err)
 }
 
-func (g *OracleContractsTestSuite) TestUpdateConfiguration() {
-	_, addr := newPrefundAccount(g.stateDB)
+func (g *OracleContractsTestSuite) TestWithdrawToContract() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
 
-	input, err := GovernanceABI.ABI.Pack("updateConfiguration",
-		new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
-		big.NewInt(1000),
-		big.NewInt(2e9),
-		big.NewInt(8000000),
-		big.NewInt(250),
-		big.NewInt(2500),
-		big.NewInt(int64(70.5*decimalMultiplier)),
-		big.NewInt(264*decimalMultiplier),
-		big.NewInt(600),
-		big.NewInt(900),
-		[]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)})
+	// Node owned by a contract.
+	g.stateDB.SetCode(addr, []byte{0x60, 0x00, 0x60, 0x00, 0xfd})
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
 
-	// Call with non-owner.
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NotNil(err)
-
-	// Call with owner.
-	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
-}
-
-func (g *OracleContractsTestSuite) TestConfigurationReading() {
-	_, addr := newPrefundAccount(g.stateDB)
 
-	// CRS.
-	input, err := GovernanceABI.ABI.Pack("crs")
-	g.Require().NoError(err)
-	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-	var crs0 [32]byte
-	err = GovernanceABI.ABI.Unpack(&crs0, "crs", res)
-	g.Require().NoError(err)
-	g.Require().Equal(crypto.Keccak256Hash([]byte(g.config.GenesisCRSText)),
-		common.BytesToHash(crs0[:]))
+	node := g.s.Node(big.NewInt(0))
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+
+	// Node storage must be finalized before the fund is transferred.
+	transfer := g.context.Transfer
+	transferred := false
+	g.context.Transfer = func(db StateDB, sender, recipient common.Address, value *big.Int) {
+		if sender == GovernanceContractAddress && recipient == addr {
+			g.Require().Equal(int64(-1), g.s.NodesOffsetByAddress(addr).Int64())
+			g.Require().Equal(int64(0), g.s.LenNodes().Int64())
+			transferred = true
+		}
+		transfer(db, sender, recipient, value)
+	}
+	defer func() { g.context.Transfer = transfer }()
 
-	// CRSRound.
-	input, err = GovernanceABI.ABI.Pack("crsRound")
+	balance := g.stateDB.GetBalance(addr)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
+	g.Require().True(transferred)
+	g.Require().Equal(0, g.stateDB.GetBalance(addr).Cmp(new(big.Int).Add(balance, amount)))
+}
 
-	// Owner.
-	input, err = GovernanceABI.ABI.Pack("owner")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-	var owner common.Address
-	err = GovernanceABI.ABI.Unpack(&owner, "owner", res)
-	g.Require().NoError(err)
-	g.Require().Equal(g.config.Owner, owner)
+func (g *OracleContractsTestSuite) TestUnstakeFee() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
 
-	// MinStake.
-	input, err = GovernanceABI.ABI.Pack("minStake")
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
-	var value *big.Int
-	err = GovernanceABI.ABI.Unpack(&value, "minStake", res)
+
+	// Set a 1% fee with non-owner.
+	input, err = GovernanceABI.ABI.Pack("setUnstakeFee", big.NewInt(100))
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.MinStake.String(), value.String())
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
 
-	// BlockReward.
-	input, err = GovernanceABI.ABI.Pack("miningVelocity")
+	// Fee above 100% should fail.
+	input, err = GovernanceABI.ABI.Pack("setUnstakeFee", big.NewInt(10001))
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	input, err = GovernanceABI.ABI.Pack("setUnstakeFee", big.NewInt(100))
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "miningVelocity", res)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.MiningVelocity, float32(value.Uint64())/decimalMultiplier)
 
-	// BlockGasLimit.
-	input, err = GovernanceABI.ABI.Pack("blockGasLimit")
+	input, err = GovernanceABI.ABI.Pack("unstakeFee")
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "blockGasLimit", res)
+	var value = new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "unstakeFee", res)
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.BlockGasLimit, value.Uint64())
+	g.Require().Equal(int64(100), value.Int64())
 
-	// LambdaBA.
-	input, err = GovernanceABI.ABI.Pack("lambdaBA")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	pool := g.s.AwardPool()
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "lambdaBA", res)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.LambdaBA, value.Uint64())
 
-	// LambdaDKG.
-	input, err = GovernanceABI.ABI.Pack("lambdaDKG")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	fee := new(big.Int).Div(amount, big.NewInt(100))
+	net := new(big.Int).Sub(amount, fee)
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(net.String(), node.Unstaked.String())
+	g.Require().Equal(new(big.Int).Add(pool, fee).String(), g.s.AwardPool().String())
+	g.Require().Equal(0, g.s.TotalStaked().Sign())
+
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["Unstaked"].Id(), log.Topics[0])
+	g.Require().Equal(amount.String(), new(big.Int).SetBytes(log.Data[:32]).String())
+	g.Require().Equal(fee.String(), new(big.Int).SetBytes(log.Data[32:]).String())
+
+	// Withdraw pays out the net amount only.
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+	balance := g.stateDB.GetBalance(addr)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "lambdaDKG", res)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.LambdaDKG, value.Uint64())
+	g.Require().Equal(new(big.Int).Add(balance, net).String(), g.stateDB.GetBalance(addr).String())
+}
 
-	// NotarySetSize.
-	input, err = GovernanceABI.ABI.Pack("notarySetSize")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+func (g *OracleContractsTestSuite) TestUnstakeFeeBeforeUpgrade() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "notarySetSize", res)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
-	g.Require().True(uint32(value.Uint64()) > 0)
 
-	// DKGRound.
-	input, err = GovernanceABI.ABI.Pack("dkgRound")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.s.SetUnstakeFee(big.NewInt(100))
+	g.downgrade()
+
+	// The fee can not be configured before the upgrade.
+	input, err = GovernanceABI.ABI.Pack("setUnstakeFee", big.NewInt(100))
 	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
 
-	// DKGResetCount.
-	input, err = GovernanceABI.ABI.Pack("dkgResetCount", big.NewInt(3))
+	// Nor is it charged.
+	pool := g.s.AwardPool()
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
+	g.Require().Equal(amount.String(), g.s.Node(big.NewInt(0)).Unstaked.String())
+	g.Require().Equal(pool.String(), g.s.AwardPool().String())
 
-	// RoundLength.
-	input, err = GovernanceABI.ABI.Pack("roundLength")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	// The legacy event is emitted.
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(crypto.Keccak256Hash([]byte("Unstaked(address,uint256)")), log.Topics[0])
+	g.Require().Equal(addr.Hash(), log.Topics[1])
+	g.Require().Equal(amount.String(), new(big.Int).SetBytes(log.Data).String())
+}
+
+func (g *OracleContractsTestSuite) TestFine() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	// Stake.
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "roundLength", res)
+	ownerStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	_, err = g.call(GovernanceContractAddress, addr, input, ownerStaked)
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.RoundLength, value.Uint64())
+	g.Require().Equal(1, len(g.s.QualifiedNodes()))
+	g.Require().Equal(ownerStaked, g.s.Node(big.NewInt(0)).Staked)
 
-	// MinBlockInterval.
-	input, err = GovernanceABI.ABI.Pack("minBlockInterval")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, finePayer := newPrefundAccount(g.stateDB)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+
+	// Paying to node without fine should fail.
+	input, err = GovernanceABI.ABI.Pack("payFine", addr)
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "minBlockInterval", res)
+	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
+	g.Require().NotNil(err)
+
+	// Fined.
+	offset := g.s.NodesOffsetByAddress(addr)
+	g.Require().True(offset.Cmp(big.NewInt(0)) >= 0)
+	node := g.s.Node(offset)
+	node.Fined = new(big.Int).Set(amount)
+	g.s.UpdateNode(offset, node)
+	g.s.IncTotalFined(amount)
+	node = g.s.Node(offset)
+	g.Require().Equal(0, node.Fined.Cmp(amount))
+
+	// Not qualified after fined.
+	g.Require().Equal(0, len(g.s.QualifiedNodes()))
+
+	// Cannot unstake before fines are paied.
+	input, err = GovernanceABI.ABI.Pack("unstake", big.NewInt(10))
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.MinBlockInterval, value.Uint64())
+	_, err = g.call(GovernanceContractAddress, finePayer, input, big.NewInt(0))
+	g.Require().NotNil(err)
 
-	// MinGasPrice.
-	input, err = GovernanceABI.ABI.Pack("minGasPrice")
+	// Paying more than fine should fail.
+	payAmount := new(big.Int).Add(amount, amount)
+	input, err = GovernanceABI.ABI.Pack("payFine", addr)
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, finePayer, input, payAmount)
+	g.Require().NotNil(err)
+
+	// Pay the fine from an address unrelated to the node.
+	input, err = GovernanceABI.ABI.Pack("payFine", addr)
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "minGasPrice", res)
+	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
 	g.Require().NoError(err)
-	g.Require().Equal(g.config.MinGasPrice, value)
+	g.Require().Equal(0, g.s.Node(offset).Fined.Sign())
+
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["FinePaid"].Id(), log.Topics[0])
+	g.Require().Equal(addr.Hash(), log.Topics[1])
+	g.Require().Equal(finePayer.Hash(), log.Topics[2])
+	g.Require().Equal(amount, new(big.Int).SetBytes(log.Data))
+
+	// Qualified.
+	g.Require().Equal(1, len(g.s.QualifiedNodes()))
 }
 
-func (g *OracleContractsTestSuite) TestReportForkVote() {
-	key, addr := newPrefundAccount(g.stateDB)
-	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+func (g *OracleContractsTestSuite) TestPause() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
 
-	// Stake.
 	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
-	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
 
-	pubKey := coreEcdsa.NewPublicKeyFromECDSA(&key.PublicKey)
-	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
-	vote1 := coreTypes.NewVote(coreTypes.VoteCom, coreCommon.NewRandomHash(), uint64(0))
-	vote1.ProposerID = coreTypes.NewNodeID(pubKey)
-
-	vote2 := vote1.Clone()
-	for vote2.BlockHash == vote1.BlockHash {
-		vote2.BlockHash = coreCommon.NewRandomHash()
-	}
-	vote1.Signature, err = privKey.Sign(coreUtils.HashVote(vote1))
+	// Call with non-owner.
+	input, err = GovernanceABI.ABI.Pack("setPaused", true)
 	g.Require().NoError(err)
-	vote2.Signature, err = privKey.Sign(coreUtils.HashVote(vote2))
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NotNil(err)
+	g.Require().False(g.s.Paused())
+
+	// Call with owner.
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
+	g.Require().True(g.s.Paused())
 
-	vote1Bytes, err := rlp.EncodeToBytes(vote1)
+	input, err = GovernanceABI.ABI.Pack("paused")
 	g.Require().NoError(err)
-	vote2Bytes, err := rlp.EncodeToBytes(vote2)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
+	var paused bool
+	err = GovernanceABI.ABI.Unpack(&paused, "paused", res)
+	g.Require().NoError(err)
+	g.Require().True(paused)
 
-	// Report wrong type (fork block)
-	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), vote1Bytes, vote2Bytes)
+	// Staking related methods are rejected while paused.
+	input, err = GovernanceABI.ABI.Pack("stake")
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NotNil(err)
 
-	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-
-	node := g.s.Node(big.NewInt(0))
-	g.Require().Equal(node.Fined, g.s.FineValue(big.NewInt(FineTypeForkVote)))
+	g.Require().NotNil(err)
 
-	// Duplicate report should fail.
-	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
 	g.Require().NoError(err)
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().Error(err)
-
-	// Check if finedRecords is set.
-	payloads := [][]byte{vote1Bytes, vote2Bytes}
-	sort.Sort(sortBytes(payloads))
+	g.Require().NotNil(err)
 
-	hash := Bytes32(crypto.Keccak256Hash(payloads...))
-	input, err = GovernanceABI.ABI.Pack("finedRecords", hash)
+	// Unpause.
+	input, err = GovernanceABI.ABI.Pack("setPaused", false)
 	g.Require().NoError(err)
-	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
+	g.Require().False(g.s.Paused())
 
-	var value bool
-	err = GovernanceABI.ABI.Unpack(&value, "finedRecords", res)
+	input, err = GovernanceABI.ABI.Pack("stake")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
-	g.Require().True(value)
 }
 
-func (g *OracleContractsTestSuite) TestReportForkBlock() {
-	key, addr := newPrefundAccount(g.stateDB)
-	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+func (g *OracleContractsTestSuite) TestTotalFined() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
 
-	// Stake.
-	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
-	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei, Taiwan", "https://dexon.org")
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	ownerStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	_, err = g.call(GovernanceContractAddress, addr, input, ownerStaked)
 	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.TotalFined().Cmp(big.NewInt(0)))
 
-	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
-	block1 := &coreTypes.Block{
-		ProposerID: coreTypes.NewNodeID(privKey.PublicKey()),
-		ParentHash: coreCommon.NewRandomHash(),
-		Timestamp:  time.Now(),
-	}
+	// Fined.
+	node := g.s.Node(g.s.NodesOffsetByAddress(addr))
+	g.Require().NoError(g.s.Disqualify(node))
+	amount := g.s.FineValue(big.NewInt(FineTypeFailStop))
+	g.Require().Equal(0, g.s.TotalFined().Cmp(amount))
 
-	block2 := block1.Clone()
-	for block2.ParentHash == block1.ParentHash {
-		block2.ParentHash = coreCommon.NewRandomHash()
-	}
+	input, err = GovernanceABI.ABI.Pack("totalFined")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	value := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "totalFined", res)
+	g.Require().NoError(err)
+	g.Require().Equal(0, value.Cmp(amount))
 
-	hashBlock := func(block *coreTypes.Block) coreCommon.Hash {
-		block.PayloadHash = coreCrypto.Keccak256Hash(block.Payload)
-		var err error
+	// Pay the fine.
+	_, finePayer := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("payFine", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.TotalFined().Cmp(big.NewInt(0)))
+
+	// The counter is left untouched before the upgrade.
+	g.downgrade()
+	g.Require().NoError(g.s.Disqualify(node))
+	g.Require().Equal(0, g.s.TotalFined().Cmp(big.NewInt(0)))
+
+	// The upgrade starts it from the outstanding fines, so paying the fine
+	// issued before it does not underflow.
+	g.s.Upgrade()
+	g.Require().Equal(0, g.s.TotalFined().Cmp(amount))
+	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.TotalFined().Cmp(big.NewInt(0)))
+	g.Require().Equal(errStateUnderflow, g.s.DecTotalFined(big.NewInt(1)))
+}
+
+func (g *OracleContractsTestSuite) TestPayFineAcrossUpgrade() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	ownerStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	_, err = g.call(GovernanceContractAddress, addr, input, ownerStaked)
+	g.Require().NoError(err)
+
+	g.downgrade()
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	offset := g.s.NodesOffsetByAddress(addr)
+	node := g.s.Node(offset)
+	node.Fined = new(big.Int).Mul(amount, big.NewInt(2))
+	g.s.UpdateNode(offset, node)
+
+	_, finePayer := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("payFine", addr)
+	g.Require().NoError(err)
+	pay := func() {
+		_, err := g.call(GovernanceContractAddress, finePayer, input, amount)
+		g.Require().NoError(err)
+	}
+
+	// Before the upgrade the paid fine goes to the owner.
+	ownerBalance := g.stateDB.GetBalance(g.config.Owner)
+	pay()
+	g.Require().Equal(new(big.Int).Add(ownerBalance, amount).String(),
+		g.stateDB.GetBalance(g.config.Owner).String())
+	g.Require().Equal(0, g.s.AwardPool().Sign())
+
+	// The legacy event has no payer.
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(crypto.Keccak256Hash([]byte("FinePaid(address,uint256)")), log.Topics[0])
+	g.Require().Equal([]common.Hash{log.Topics[0], addr.Hash()}, log.Topics)
+	g.Require().Equal(amount.String(), new(big.Int).SetBytes(log.Data).String())
+
+	// After it the same call funds the award pool.
+	g.s.Upgrade()
+	ownerBalance = g.stateDB.GetBalance(g.config.Owner)
+	pay()
+	g.Require().Equal(ownerBalance.String(), g.stateDB.GetBalance(g.config.Owner).String())
+	g.Require().Equal(amount.String(), g.s.AwardPool().String())
+	g.Require().Equal(0, g.s.Node(offset).Fined.Sign())
+
+	logs = g.stateDB.Logs()
+	log = logs[len(logs)-1]
+	g.Require().Equal([]common.Hash{
+		GovernanceABI.Events["FinePaid"].Id(), addr.Hash(), finePayer.Hash()}, log.Topics)
+}
+
+func (g *OracleContractsTestSuite) TestAwardPool() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	ownerStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	_, err = g.call(GovernanceContractAddress, addr, input, ownerStaked)
+	g.Require().NoError(err)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	offset := g.s.NodesOffsetByAddress(addr)
+	node := g.s.Node(offset)
+	node.Fined = new(big.Int).Set(amount)
+	g.s.UpdateNode(offset, node)
+	g.s.IncTotalFined(amount)
+
+	ownerBalance := g.stateDB.GetBalance(g.config.Owner)
+	contractBalance := g.stateDB.GetBalance(GovernanceContractAddress)
+
+	// Pay the fine.
+	_, finePayer := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("payFine", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, finePayer, input, amount)
+	g.Require().NoError(err)
+
+	g.Require().Equal(0, g.s.AwardPool().Cmp(amount))
+	g.Require().Equal(0, g.stateDB.GetBalance(g.config.Owner).Cmp(ownerBalance))
+	g.Require().Equal(0, g.stateDB.GetBalance(GovernanceContractAddress).Cmp(
+		new(big.Int).Add(contractBalance, amount)))
+
+	input, err = GovernanceABI.ABI.Pack("awardPoolBalance")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	value := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "awardPoolBalance", res)
+	g.Require().NoError(err)
+	g.Require().Equal(0, value.Cmp(amount))
+
+	// Drain is capped by the pool balance.
+	half := new(big.Int).Div(amount, big.NewInt(2))
+	g.Require().Equal(0, g.s.DrainAwardPool(half).Cmp(half))
+	g.Require().Equal(0, g.s.AwardPool().Cmp(new(big.Int).Sub(amount, half)))
+	g.Require().Equal(0, g.s.DrainAwardPool(amount).Cmp(new(big.Int).Sub(amount, half)))
+	g.Require().Equal(0, g.s.AwardPool().Cmp(big.NewInt(0)))
+	g.Require().Equal(0, g.stateDB.GetBalance(GovernanceContractAddress).Cmp(contractBalance))
+}
+
+func (g *OracleContractsTestSuite) TestTreasury() {
+	_, treasury := newPrefundAccount(g.stateDB)
+	_, addr := newPrefundAccount(g.stateDB)
+
+	pool := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+	g.s.IncAwardPool(pool)
+	g.stateDB.AddBalance(GovernanceContractAddress, pool)
+	contractBalance := g.stateDB.GetBalance(GovernanceContractAddress)
+	treasuryBalance := g.stateDB.GetBalance(treasury)
+
+	// Sweeping without a treasury should fail.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(4))
+	input, err := GovernanceABI.ABI.Pack("sweepTreasury", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Only owner can set treasury.
+	input, err = GovernanceABI.ABI.Pack("setTreasury", treasury)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("treasury")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var value common.Address
+	err = GovernanceABI.ABI.Unpack(&value, "treasury", res)
+	g.Require().NoError(err)
+	g.Require().Equal(treasury, value)
+
+	// Only owner can sweep.
+	input, err = GovernanceABI.ABI.Pack("sweepTreasury", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	g.Require().Equal(0, g.s.AwardPool().Cmp(new(big.Int).Sub(pool, amount)))
+	g.Require().Equal(0, g.stateDB.GetBalance(treasury).Cmp(new(big.Int).Add(treasuryBalance, amount)))
+	g.Require().Equal(0, g.stateDB.GetBalance(GovernanceContractAddress).Cmp(
+		new(big.Int).Sub(contractBalance, amount)))
+
+	// Sweeping more than the award pool should fail.
+	input, err = GovernanceABI.ABI.Pack("sweepTreasury", pool)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().Equal(0, g.s.AwardPool().Cmp(new(big.Int).Sub(pool, amount)))
+}
+
+func (g *OracleContractsTestSuite) TestForceUnstake() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	// Node without fine can not be forced out.
+	input, err = GovernanceABI.ABI.Pack("forceUnstake", addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	fine := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	node := g.s.Node(big.NewInt(0))
+	node.Fined = new(big.Int).Set(fine)
+	g.s.UpdateNode(big.NewInt(0), node)
+	g.s.IncTotalFined(fine)
+
+	// Non-owner should fail.
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	pool := g.s.AwardPool()
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	net := new(big.Int).Sub(amount, fine)
+	node = g.s.Node(big.NewInt(0))
+	g.Require().Equal(0, node.Staked.Sign())
+	g.Require().Equal(0, node.Fined.Sign())
+	g.Require().Equal(net.String(), node.Unstaked.String())
+	g.Require().True(node.UnstakedAt.Sign() > 0)
+	g.Require().Equal(0, g.s.TotalStaked().Sign())
+	g.Require().Equal(0, g.s.TotalFined().Sign())
+	g.Require().Equal(new(big.Int).Add(pool, fine).String(), g.s.AwardPool().String())
+
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["ForceUnstaked"].Id(), log.Topics[0])
+	g.Require().Equal(addr.Hash(), log.Topics[1])
+	g.Require().Equal(amount.String(), new(big.Int).SetBytes(log.Data[:32]).String())
+	g.Require().Equal(fine.String(), new(big.Int).SetBytes(log.Data[32:]).String())
+
+	// The rest can be withdrawn after lockup.
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+	balance := g.stateDB.GetBalance(addr)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(new(big.Int).Add(balance, net).String(), g.stateDB.GetBalance(addr).String())
+	g.Require().Equal(0, int(g.s.LenNodes().Int64()))
+}
+
+func (g *OracleContractsTestSuite) TestForgiveFine() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	ownerStaked := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	_, err = g.call(GovernanceContractAddress, addr, input, ownerStaked)
+	g.Require().NoError(err)
+	totalStaked := g.s.TotalStaked()
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+
+	// Forgiving node without fine should fail.
+	input, err = GovernanceABI.ABI.Pack("forgiveFine", addr, amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NotNil(err)
+
+	// Fined.
+	offset := g.s.NodesOffsetByAddress(addr)
+	node := g.s.Node(offset)
+	node.Fined = new(big.Int).Set(amount)
+	g.s.UpdateNode(offset, node)
+	g.s.IncTotalFined(amount)
+
+	// Call with non-owner.
+	half := new(big.Int).Div(amount, big.NewInt(2))
+	input, err = GovernanceABI.ABI.Pack("forgiveFine", addr, half)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NotNil(err)
+
+	// Partially forgive.
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.Node(offset).Fined.Cmp(new(big.Int).Sub(amount, half)))
+
+	// Forgiving more than the fine is clamped.
+	input, err = GovernanceABI.ABI.Pack("forgiveFine", addr, amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.Node(offset).Fined.Cmp(big.NewInt(0)))
+
+	// Stake is untouched.
+	g.Require().Equal(0, g.s.Node(offset).Staked.Cmp(ownerStaked))
+	g.Require().Equal(0, g.s.TotalStaked().Cmp(totalStaked))
+	g.Require().Equal(1, len(g.s.QualifiedNodes()))
+}
+
+func (g *OracleContractsTestSuite) TestQualifiedNodesCount() {
+	// Not tracked before the upgrade.
+	g.downgrade()
+	g.s.setStateBigInt(big.NewInt(qualifiedNodesCountLoc), big.NewInt(0))
+	g.Require().False(g.s.qualifiedNodesCountTracked())
+
+	var addrs []common.Address
+	for i := 0; i < 2; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, g.config.MinStake)
+		g.Require().NoError(err)
+		addrs = append(addrs, addr)
+	}
+	g.Require().False(g.s.qualifiedNodesCountTracked())
+	g.Require().Equal(int64(2), g.s.QualifiedNodesCount().Int64())
+
+	// The upgrade starts the counter from the current state.
+	g.Require().False(g.s.Upgraded())
+	g.s.Upgrade()
+	g.Require().True(g.s.Upgraded())
+	g.Require().True(g.s.qualifiedNodesCountTracked())
+	g.Require().Equal(int64(2), g.s.QualifiedNodesCount().Int64())
+	g.Require().Panics(func() { g.s.Upgrade() })
+
+	// Unstaking below min stake disqualifies the node.
+	input, err := GovernanceABI.ABI.Pack("unstake", big.NewInt(1))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addrs[0], input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("qualifiedNodesCount")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addrs[0], input, big.NewInt(0))
+	g.Require().NoError(err)
+	value := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "qualifiedNodesCount", res)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), value.Int64())
+
+	// Untracked counter falls back to iteration.
+	g.s.setStateBigInt(big.NewInt(qualifiedNodesCountLoc), big.NewInt(0))
+	g.Require().Equal(int64(1), g.s.QualifiedNodesCount().Int64())
+}
+
+func (g *OracleContractsTestSuite) TestEffectiveStake() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	effectiveStake := func() *big.Int {
+		input, err := GovernanceABI.ABI.Pack("effectiveStake", addr)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		value := new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&value, "effectiveStake", res)
+		g.Require().NoError(err)
+		return value
+	}
+	g.Require().Equal(0, effectiveStake().Cmp(amount))
+
+	fine := new(big.Int).Div(amount, big.NewInt(4))
+	node := g.s.Node(big.NewInt(0))
+	node.Fined = fine
+	g.s.UpdateNode(big.NewInt(0), node)
+	g.Require().Equal(0, effectiveStake().Cmp(new(big.Int).Sub(amount, fine)))
+
+	// Fine exceeding stake is clamped at zero.
+	node.Fined = new(big.Int).Mul(amount, big.NewInt(2))
+	g.s.UpdateNode(big.NewInt(0), node)
+	g.Require().Equal(0, effectiveStake().Cmp(big.NewInt(0)))
+
+	// Unknown node.
+	_, unknown := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("effectiveStake", unknown)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestStakeTotal() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	stakeTotal := func(addr common.Address) *big.Int {
+		input, err := GovernanceABI.ABI.Pack("stakeTotal", addr)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		value := new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&value, "stakeTotal", res)
+		g.Require().NoError(err)
+		return value
+	}
+
+	// Unknown node returns zero.
+	g.Require().Equal(0, stakeTotal(addr).Cmp(big.NewInt(0)))
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+	g.Require().Equal(0, stakeTotal(addr).Cmp(amount))
+
+	// Pending withdrawal is included.
+	input, err = GovernanceABI.ABI.Pack("unstake", new(big.Int).Div(amount, big.NewInt(2)))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(0, stakeTotal(addr).Cmp(amount))
+}
+
+func (g *OracleContractsTestSuite) TestStakeAmount() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	// Register without stake and fund later.
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	input, err = GovernanceABI.ABI.Pack("stakeAmount", amount)
+	g.Require().NoError(err)
+
+	// Value sent must match the stated amount.
+	for _, value := range []*big.Int{big.NewInt(0), new(big.Int).Add(amount, big.NewInt(1))} {
+		_, err = g.call(GovernanceContractAddress, addr, input, value)
+		g.Require().Error(err)
+		g.Require().Equal(0, g.s.Node(big.NewInt(0)).Staked.Sign())
+	}
+
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.Node(big.NewInt(0)).Staked.Cmp(amount))
+	g.Require().Equal(0, g.s.TotalStaked().Cmp(amount))
+}
+
+func (g *OracleContractsTestSuite) TestReentrancyGuard() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("unstake", amount)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	node := g.s.Node(big.NewInt(0))
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+
+	var reentries [][]byte
+	for _, args := range [][]interface{}{
+		{"stake"},
+		{"stakeAmount", amount},
+		{"unstake", amount},
+		{"withdraw"},
+		{"register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org"},
+		{"registerWithSignature", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org", []byte{}},
+	} {
+		input, err := GovernanceABI.ABI.Pack(args[0].(string), args[1:]...)
+		g.Require().NoError(err)
+		reentries = append(reentries, input)
+	}
+
+	// Call back into the governance contract while withdraw pays out.
+	var evm *EVM
+	var reasons []string
+	transfer := g.context.Transfer
+	g.context.Transfer = func(db StateDB, sender, recipient common.Address, amount *big.Int) {
+		if sender == GovernanceContractAddress {
+			for _, input := range reentries {
+				res, _, err := evm.Call(AccountRef(recipient), GovernanceContractAddress, input, 1000000, big.NewInt(0))
+				g.Require().Error(err)
+				var reason string
+				g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+				reasons = append(reasons, reason)
+			}
+		}
+		transfer(db, sender, recipient, amount)
+	}
+	g.context.Time = big.NewInt(time.Now().UnixNano() / 1000000)
+	evm = NewEVM(g.context, g.stateDB, params.TestChainConfig, Config{IsBlockProposer: true})
+
+	balance := g.stateDB.GetBalance(addr)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, _, err = evm.Call(AccountRef(addr), GovernanceContractAddress, input, 10000000, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Len(reasons, len(reentries))
+	for _, reason := range reasons {
+		g.Require().Equal("reentrant call", reason)
+	}
+	g.Require().Equal(new(big.Int).Add(balance, amount).String(), g.stateDB.GetBalance(addr).String())
+	g.Require().Equal(0, len(g.s.Nodes()))
+
+	// The lock is released once the call returns.
+	g.Require().False(evm.governanceLocked)
+	_, _, err = evm.Call(AccountRef(addr), GovernanceContractAddress, reentries[4], 10000000, amount)
+	g.Require().NoError(err)
+	g.Require().Equal(1, len(g.s.Nodes()))
+	g.Require().Equal(amount.String(), g.s.Node(big.NewInt(0)).Staked.String())
+}
+
+func (g *OracleContractsTestSuite) TestReconcile() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	values := struct {
+		ContractBalance *big.Int
+		TotalStaked     *big.Int
+		TotalFined      *big.Int
+		AwardPool       *big.Int
+		TotalUnstaked   *big.Int
+	}{}
+	reconcile := func() {
+		input, err := GovernanceABI.ABI.Pack("reconcile")
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		err = GovernanceABI.ABI.Unpack(&values, "reconcile", res)
+		g.Require().NoError(err)
+	}
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	pool := big.NewInt(1e18)
+	g.s.IncAwardPool(pool)
+	g.stateDB.AddBalance(GovernanceContractAddress, pool)
+
+	reconcile()
+	g.Require().Equal(g.stateDB.GetBalance(GovernanceContractAddress).String(), values.ContractBalance.String())
+	g.Require().Equal(amount.String(), values.TotalStaked.String())
+	g.Require().Equal(0, values.TotalFined.Sign())
+	g.Require().Equal(pool.String(), values.AwardPool.String())
+	g.Require().Equal(0, values.TotalUnstaked.Sign())
+	g.Require().True(values.ContractBalance.Cmp(
+		new(big.Int).Add(values.TotalStaked, values.AwardPool)) >= 0)
+
+	// Unstaked funds are tracked until withdrawn.
+	half := new(big.Int).Div(amount, big.NewInt(2))
+	input, err = GovernanceABI.ABI.Pack("unstake", half)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	reconcile()
+	g.Require().Equal(half.String(), values.TotalStaked.String())
+	g.Require().Equal(half.String(), values.TotalUnstaked.String())
+	g.Require().Equal(half.String(), g.s.TotalUnstaked().String())
+	// The contract is seeded with 1 wei at setup.
+	g.Require().Equal(values.ContractBalance.String(), new(big.Int).Add(
+		new(big.Int).Add(values.TotalStaked, values.TotalUnstaked),
+		new(big.Int).Add(values.AwardPool, big.NewInt(1))).String())
+
+	node := g.s.Node(big.NewInt(0))
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	reconcile()
+	g.Require().Equal(0, values.TotalUnstaked.Sign())
+	g.Require().Equal(values.ContractBalance.String(), new(big.Int).Add(
+		values.TotalStaked, new(big.Int).Add(values.AwardPool, big.NewInt(1))).String())
+}
+
+func (g *OracleContractsTestSuite) TestWithdrawUnstakedBeforeUpgrade() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	half := new(big.Int).Div(amount, big.NewInt(2))
+	input, err = GovernanceABI.ABI.Pack("unstake", half)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// Simulate an unstake from before the counter existed.
+	g.downgrade()
+	g.s.setStateBigInt(big.NewInt(totalUnstakedLoc), big.NewInt(0))
+
+	// The upgrade seeds the counter from the nodes.
+	g.s.Upgrade()
+	g.Require().Equal(half.String(), g.s.TotalUnstaked().String())
+
+	// Withdrawing while the counter misses the funds does not underflow it.
+	g.s.setStateBigInt(big.NewInt(totalUnstakedLoc), big.NewInt(0))
+	node := g.s.Node(big.NewInt(0))
+	node.UnstakedAt = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+	balance := g.stateDB.GetBalance(addr)
+	input, err = GovernanceABI.ABI.Pack("withdraw")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.TotalUnstaked().Sign())
+	g.Require().Equal(new(big.Int).Add(balance, half).String(), g.stateDB.GetBalance(addr).String())
+}
+
+func (g *OracleContractsTestSuite) TestUpdateConfiguration() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	input, err := GovernanceABI.ABI.Pack("updateConfiguration",
+		new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+		big.NewInt(1000),
+		big.NewInt(2e9),
+		big.NewInt(8000000),
+		big.NewInt(250),
+		big.NewInt(2500),
+		big.NewInt(int64(70.5*decimalMultiplier)),
+		big.NewInt(264*decimalMultiplier),
+		big.NewInt(600),
+		big.NewInt(900),
+		[]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)})
+	g.Require().NoError(err)
+
+	// Call with non-owner.
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NotNil(err)
+
+	// Call with owner.
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// The event carries the new configuration.
+	logs := g.stateDB.Logs()
+	g.Require().NotEmpty(logs)
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["ConfigurationChanged"].Id(), log.Topics[0])
+	var cfg rawConfigStruct
+	err = GovernanceABI.ABI.Methods["updateConfiguration"].Inputs.Unpack(&cfg, log.Data)
+	g.Require().NoError(err)
+	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)).String(), cfg.MinStake.String())
+	g.Require().Equal(int64(1000), cfg.LockupPeriod.Int64())
+	g.Require().Equal(int64(2e9), cfg.BlockGasLimit.Int64())
+	g.Require().Equal(int64(8000000), cfg.MinGasPrice.Int64())
+	g.Require().Equal(int64(250), cfg.LambdaBA.Int64())
+	g.Require().Equal(int64(2500), cfg.LambdaDKG.Int64())
+	g.Require().Equal(int64(70.5*decimalMultiplier), cfg.NotaryParamAlpha.Int64())
+	g.Require().Equal(int64(264*decimalMultiplier), cfg.NotaryParamBeta.Int64())
+	g.Require().Equal(int64(600), cfg.RoundLength.Int64())
+	g.Require().Equal(int64(900), cfg.MinBlockInterval.Int64())
+	g.Require().Len(cfg.FineValues, 5)
+	for _, value := range cfg.FineValues {
+		g.Require().Equal(int64(1), value.Int64())
+	}
+
+	// Before the upgrade the event carries no data.
+	g.downgrade()
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	logs = g.stateDB.Logs()
+	log = logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["ConfigurationChanged"].Id(), log.Topics[0])
+	g.Require().Empty(log.Data)
+}
+
+func (g *OracleContractsTestSuite) TestMinStakeChanged() {
+	oldMinStake := g.s.MinStake()
+	newMinStake := new(big.Int).Mul(oldMinStake, big.NewInt(2))
+
+	update := func(minStake *big.Int) {
+		cfg := g.s.rawConfiguration()
+		input, err := GovernanceABI.ABI.Pack("updateConfiguration",
+			minStake, cfg.LockupPeriod, cfg.BlockGasLimit, cfg.MinGasPrice,
+			cfg.LambdaBA, cfg.LambdaDKG, cfg.NotaryParamAlpha, cfg.NotaryParamBeta,
+			cfg.RoundLength, cfg.MinBlockInterval, cfg.FineValues)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		g.Require().NoError(err)
+	}
+	minStakeChanged := func() [][]byte {
+		var data [][]byte
+		for _, log := range g.stateDB.Logs() {
+			if log.Topics[0] == GovernanceABI.Events["MinStakeChanged"].Id() {
+				data = append(data, log.Data)
+			}
+		}
+		return data
+	}
+
+	update(newMinStake)
+	data := minStakeChanged()
+	g.Require().Len(data, 1)
+	values := struct {
+		OldMinStake *big.Int
+		NewMinStake *big.Int
+	}{}
+	err := GovernanceABI.ABI.Unpack(&values, "MinStakeChanged", data[0])
+	g.Require().NoError(err)
+	g.Require().Equal(oldMinStake.String(), values.OldMinStake.String())
+	g.Require().Equal(newMinStake.String(), values.NewMinStake.String())
+
+	// Unchanged value emits nothing.
+	update(newMinStake)
+	g.Require().Len(minStakeChanged(), 1)
+
+	input, err := GovernanceABI.ABI.Pack("roundMinStake", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	value := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "roundMinStake", res)
+	g.Require().NoError(err)
+	g.Require().Equal(newMinStake.String(), value.String())
+}
+
+func (g *OracleContractsTestSuite) TestUpdateConfigurationNotarySetSize() {
+	pack := func(alpha, beta, roundLength int64) []byte {
+		input, err := GovernanceABI.ABI.Pack("updateConfiguration",
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6)),
+			big.NewInt(1000),
+			big.NewInt(2e9),
+			big.NewInt(8000000),
+			big.NewInt(250),
+			big.NewInt(2500),
+			big.NewInt(alpha),
+			big.NewInt(beta),
+			big.NewInt(roundLength),
+			big.NewInt(900),
+			[]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1), big.NewInt(1)})
+		g.Require().NoError(err)
+		return input
+	}
+
+	// Zero sized notary set.
+	_, err := g.call(GovernanceContractAddress, g.config.Owner,
+		pack(0, 0, 600), big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner,
+		pack(int64(70.5*decimalMultiplier), 400*decimalMultiplier, 600), big.NewInt(0))
+	g.Require().Error(err)
+
+	// Notary set larger than the node count.
+	_, err = g.call(GovernanceContractAddress, g.config.Owner,
+		pack(100*decimalMultiplier, 0, 600), big.NewInt(0))
+	g.Require().Error(err)
+
+	// Zero round length.
+	_, err = g.call(GovernanceContractAddress, g.config.Owner,
+		pack(int64(70.5*decimalMultiplier), 264*decimalMultiplier, 0), big.NewInt(0))
+	g.Require().Error(err)
+
+	_, err = g.call(GovernanceContractAddress, g.config.Owner,
+		pack(int64(70.5*decimalMultiplier), 264*decimalMultiplier, 600), big.NewInt(0))
+	g.Require().NoError(err)
+}
+
+func (g *OracleContractsTestSuite) TestSetConfigValue() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	for name, getter := range map[string]func() *big.Int{
+		"setMinGasPrice":   g.s.MinGasPrice,
+		"setRoundLength":   g.s.RoundLength,
+		"setBlockGasLimit": g.s.BlockGasLimit,
+	} {
+		value := new(big.Int).Add(getter(), big.NewInt(1))
+		input, err := GovernanceABI.ABI.Pack(name, value)
+		g.Require().NoError(err)
+
+		// Call with non-owner.
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Error(err, name)
+
+		// Call with owner.
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		g.Require().NoError(err, name)
+		g.Require().Equal(0, getter().Cmp(value), name)
+
+		// Zero value should fail.
+		input, err = GovernanceABI.ABI.Pack(name, big.NewInt(0))
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		g.Require().Error(err, name)
+		g.Require().Equal(0, getter().Cmp(value), name)
+	}
+}
+
+func (g *OracleContractsTestSuite) TestConfigurationReading() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	// CRS.
+	input, err := GovernanceABI.ABI.Pack("crs")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var crs0 [32]byte
+	err = GovernanceABI.ABI.Unpack(&crs0, "crs", res)
+	g.Require().NoError(err)
+	g.Require().Equal(crypto.Keccak256Hash([]byte(g.config.GenesisCRSText)),
+		common.BytesToHash(crs0[:]))
+
+	// CRSRound.
+	input, err = GovernanceABI.ABI.Pack("crsRound")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// Owner.
+	input, err = GovernanceABI.ABI.Pack("owner")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var owner common.Address
+	err = GovernanceABI.ABI.Unpack(&owner, "owner", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.Owner, owner)
+
+	// MinStake.
+	input, err = GovernanceABI.ABI.Pack("minStake")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var value *big.Int
+	err = GovernanceABI.ABI.Unpack(&value, "minStake", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.MinStake.String(), value.String())
+
+	// BlockReward.
+	input, err = GovernanceABI.ABI.Pack("miningVelocity")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "miningVelocity", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.MiningVelocity, float32(value.Uint64())/decimalMultiplier)
+
+	// BlockGasLimit.
+	input, err = GovernanceABI.ABI.Pack("blockGasLimit")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "blockGasLimit", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.BlockGasLimit, value.Uint64())
+
+	// LambdaBA.
+	input, err = GovernanceABI.ABI.Pack("lambdaBA")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "lambdaBA", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.LambdaBA, value.Uint64())
+
+	// LambdaDKG.
+	input, err = GovernanceABI.ABI.Pack("lambdaDKG")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "lambdaDKG", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.LambdaDKG, value.Uint64())
+
+	// NotarySetSize.
+	input, err = GovernanceABI.ABI.Pack("notarySetSize")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "notarySetSize", res)
+	g.Require().NoError(err)
+	g.Require().True(uint32(value.Uint64()) > 0)
+
+	// DKGRound.
+	input, err = GovernanceABI.ABI.Pack("dkgRound")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// DKGResetCount.
+	input, err = GovernanceABI.ABI.Pack("dkgResetCount", big.NewInt(3))
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// RoundLength.
+	input, err = GovernanceABI.ABI.Pack("roundLength")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "roundLength", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.RoundLength, value.Uint64())
+
+	// MinBlockInterval.
+	input, err = GovernanceABI.ABI.Pack("minBlockInterval")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "minBlockInterval", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.MinBlockInterval, value.Uint64())
+
+	// MinGasPrice.
+	input, err = GovernanceABI.ABI.Pack("minGasPrice")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "minGasPrice", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.config.MinGasPrice, value)
+}
+
+func (g *OracleContractsTestSuite) TestFineValueByType() {
+	_, addr := newPrefundAccount(g.stateDB)
+	call := func(method string, arg interface{}) (*big.Int, error) {
+		input, err := GovernanceABI.ABI.Pack(method, arg)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		value := new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&value, method, res)
+		g.Require().NoError(err)
+		return value, nil
+	}
+
+	for name, fineType := range fineTypesByName {
+		byName, err := call("fineValueByType", name)
+		g.Require().NoError(err)
+		byIndex, err := call("fineValues", big.NewInt(int64(fineType)))
+		g.Require().NoError(err)
+		g.Require().Equal(byIndex.String(), byName.String(), name)
+	}
+
+	_, err := call("fineValueByType", "Unknown")
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestReportForkVote() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+
+	// Stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	pubKey := coreEcdsa.NewPublicKeyFromECDSA(&key.PublicKey)
+	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
+	vote1 := coreTypes.NewVote(coreTypes.VoteCom, coreCommon.NewRandomHash(), uint64(0))
+	vote1.ProposerID = coreTypes.NewNodeID(pubKey)
+
+	vote2 := vote1.Clone()
+	for vote2.BlockHash == vote1.BlockHash {
+		vote2.BlockHash = coreCommon.NewRandomHash()
+	}
+	vote1.Signature, err = privKey.Sign(coreUtils.HashVote(vote1))
+	g.Require().NoError(err)
+	vote2.Signature, err = privKey.Sign(coreUtils.HashVote(vote2))
+	g.Require().NoError(err)
+
+	vote1Bytes, err := rlp.EncodeToBytes(vote1)
+	g.Require().NoError(err)
+	vote2Bytes, err := rlp.EncodeToBytes(vote2)
+	g.Require().NoError(err)
+
+	// Report wrong type (fork block)
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), vote1Bytes, vote2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// The same vote twice is not a fork.
+	var reason string
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote1Bytes)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+	g.Require().Equal("votes not conflicting", reason)
+
+	// Votes from different proposers are not a fork.
+	otherKey, _ := newPrefundAccount(g.stateDB)
+	vote3 := vote2.Clone()
+	vote3.ProposerID = coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&otherKey.PublicKey))
+	vote3.Signature, err = coreEcdsa.NewPrivateKeyFromECDSA(otherKey).Sign(coreUtils.HashVote(vote3))
+	g.Require().NoError(err)
+	vote3Bytes, err := rlp.EncodeToBytes(vote3)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote3Bytes)
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+	g.Require().Equal("proposer mismatch", reason)
+	g.Require().Equal(0, g.s.Node(big.NewInt(0)).Fined.Sign())
+
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(node.Fined, g.s.FineValue(big.NewInt(FineTypeForkVote)))
+
+	// Duplicate report should fail.
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Check if finedRecords is set.
+	payloads := [][]byte{vote1Bytes, vote2Bytes}
+	sort.Sort(sortBytes(payloads))
+
+	hash := Bytes32(crypto.Keccak256Hash(payloads...))
+	input, err = GovernanceABI.ABI.Pack("finedRecords", hash)
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	var value bool
+	err = GovernanceABI.ABI.Unpack(&value, "finedRecords", res)
+	g.Require().NoError(err)
+	g.Require().True(value)
+}
+
+func (g *OracleContractsTestSuite) TestReportBatch() {
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	var types []*big.Int
+	var arg1s, arg2s [][]byte
+	var addrs []common.Address
+	for i := 0; i < 2; i++ {
+		key, addr := newPrefundAccount(g.stateDB)
+		pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
+		addrs = append(addrs, addr)
+
+		privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
+		vote1 := coreTypes.NewVote(coreTypes.VoteCom, coreCommon.NewRandomHash(), uint64(0))
+		vote1.ProposerID = coreTypes.NewNodeID(privKey.PublicKey())
+		vote2 := vote1.Clone()
+		for vote2.BlockHash == vote1.BlockHash {
+			vote2.BlockHash = coreCommon.NewRandomHash()
+		}
+		vote1.Signature, err = privKey.Sign(coreUtils.HashVote(vote1))
+		g.Require().NoError(err)
+		vote2.Signature, err = privKey.Sign(coreUtils.HashVote(vote2))
+		g.Require().NoError(err)
+		vote1Bytes, err := rlp.EncodeToBytes(vote1)
+		g.Require().NoError(err)
+		vote2Bytes, err := rlp.EncodeToBytes(vote2)
+		g.Require().NoError(err)
+
+		types = append(types, big.NewInt(FineTypeForkVote))
+		arg1s = append(arg1s, vote1Bytes)
+		arg2s = append(arg2s, vote2Bytes)
+
+		// Invalid entry in between.
+		if i == 0 {
+			types = append(types, big.NewInt(FineTypeForkVote))
+			arg1s = append(arg1s, vote1Bytes)
+			arg2s = append(arg2s, vote1Bytes)
+		}
+	}
+
+	_, reporter := newPrefundAccount(g.stateDB)
+
+	// Slices of different lengths should fail.
+	input, err := GovernanceABI.ABI.Pack("reportBatch", types, arg1s, arg2s[1:])
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporter, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	input, err = GovernanceABI.ABI.Pack("reportBatch", types, arg1s, arg2s)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, reporter, input, big.NewInt(0))
+	g.Require().NoError(err)
+	count := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&count, "reportBatch", res)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(2), count.Int64())
+
+	fineValue := g.s.FineValue(big.NewInt(FineTypeForkVote))
+	for _, addr := range addrs {
+		node := g.s.Node(g.s.NodesOffsetByAddress(addr))
+		g.Require().Equal(0, node.Fined.Cmp(fineValue))
+	}
+
+	// Already reported entries are skipped, but still charged for.
+	gas := uint64(10000000)
+	evm := NewEVM(g.context, g.stateDB, params.TestChainConfig, Config{IsBlockProposer: true})
+	res, leftOverGas, err := evm.Call(AccountRef(reporter), GovernanceContractAddress, input, gas, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&count, "reportBatch", res)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(0), count.Int64())
+	g.Require().Equal(uint64(len(types))*reportBatchEntryGasCost, gas-leftOverGas)
+
+	// Not enough gas for every entry.
+	_, _, err = evm.Call(AccountRef(reporter), GovernanceContractAddress, input,
+		uint64(len(types))*reportBatchEntryGasCost-1, big.NewInt(0))
+	g.Require().Equal(ErrOutOfGas, err)
+
+	// Oversized batches are rejected.
+	types, arg1s, arg2s = nil, nil, nil
+	for i := 0; i <= maxReportBatchSize; i++ {
+		types = append(types, big.NewInt(FineTypeForkVote))
+		arg1s = append(arg1s, []byte{})
+		arg2s = append(arg2s, []byte{})
+	}
+	input, err = GovernanceABI.ABI.Pack("reportBatch", types, arg1s, arg2s)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporter, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestReportCooldown() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+
+	// Stake enough to cover two fines, since fines are capped at the stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(2e6))
+	g.stateDB.AddBalance(addr, amount)
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	// Set cooldown with non-owner.
+	cooldown := big.NewInt(1e9)
+	input, err = GovernanceABI.ABI.Pack("setReportCooldown", cooldown)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Set cooldown with owner.
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(0, g.s.ReportCooldown().Cmp(cooldown))
+
+	pubKey := coreEcdsa.NewPublicKeyFromECDSA(&key.PublicKey)
+	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
+	forkVotes := func() ([]byte, []byte) {
+		vote1 := coreTypes.NewVote(coreTypes.VoteCom, coreCommon.NewRandomHash(), uint64(0))
+		vote1.ProposerID = coreTypes.NewNodeID(pubKey)
+		vote2 := vote1.Clone()
+		for vote2.BlockHash == vote1.BlockHash {
+			vote2.BlockHash = coreCommon.NewRandomHash()
+		}
+		vote1.Signature, err = privKey.Sign(coreUtils.HashVote(vote1))
+		g.Require().NoError(err)
+		vote2.Signature, err = privKey.Sign(coreUtils.HashVote(vote2))
+		g.Require().NoError(err)
+		vote1Bytes, err := rlp.EncodeToBytes(vote1)
+		g.Require().NoError(err)
+		vote2Bytes, err := rlp.EncodeToBytes(vote2)
+		g.Require().NoError(err)
+		return vote1Bytes, vote2Bytes
+	}
+
+	vote1Bytes, vote2Bytes := forkVotes()
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	forkVote := big.NewInt(FineTypeForkVote)
+	g.Require().True(g.s.LastFinedAt(addr, forkVote).Cmp(big.NewInt(0)) > 0)
+	g.Require().Equal(0, g.s.LastFinedAt(addr, big.NewInt(FineTypeForkBlock)).Sign())
+
+	// Different evidence within cooldown should fail.
+	vote1Bytes, vote2Bytes = forkVotes()
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	var reason string
+	err = revertReasonArguments.Unpack(&reason, res[4:])
+	g.Require().NoError(err)
+	g.Require().Equal("fined too recently", reason)
+	g.Require().Equal(g.s.Node(big.NewInt(0)).Fined, g.s.FineValue(big.NewInt(FineTypeForkVote)))
+
+	// After cooldown. A recent fine of another type does not block it.
+	g.s.PutLastFinedAt(addr, forkVote, big.NewInt(1))
+	g.s.PutLastFinedAt(addr, big.NewInt(FineTypeForkBlock), big.NewInt(time.Now().UnixNano()/1000000))
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(g.s.Node(big.NewInt(0)).Fined, new(big.Int).Mul(
+		g.s.FineValue(big.NewInt(FineTypeForkVote)), big.NewInt(2)))
+
+	// Before the upgrade there is no cooldown, and nothing is recorded.
+	g.downgrade()
+	lastFinedAt := big.NewInt(time.Now().UnixNano() / 1000000)
+	g.s.PutLastFinedAt(addr, forkVote, lastFinedAt)
+	vote1Bytes, vote2Bytes = forkVotes()
+	input, err = GovernanceABI.ABI.Pack("report", forkVote, vote1Bytes, vote2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(lastFinedAt, g.s.LastFinedAt(addr, forkVote))
+}
+
+func (g *OracleContractsTestSuite) TestFineCappedAtStake() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+
+	// Stake less than the fork vote fine.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+	g.Require().True(g.s.FineValue(big.NewInt(FineTypeForkVote)).Cmp(amount) > 0)
+
+	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
+	newEvidence := func() []byte {
+		vote1 := coreTypes.NewVote(coreTypes.VoteCom, coreCommon.NewRandomHash(), uint64(0))
+		vote1.ProposerID = coreTypes.NewNodeID(privKey.PublicKey())
+		vote2 := vote1.Clone()
+		for vote2.BlockHash == vote1.BlockHash {
+			vote2.BlockHash = coreCommon.NewRandomHash()
+		}
+		vote1.Signature, err = privKey.Sign(coreUtils.HashVote(vote1))
+		g.Require().NoError(err)
+		vote2.Signature, err = privKey.Sign(coreUtils.HashVote(vote2))
+		g.Require().NoError(err)
+		vote1Bytes, err := rlp.EncodeToBytes(vote1)
+		g.Require().NoError(err)
+		vote2Bytes, err := rlp.EncodeToBytes(vote2)
+		g.Require().NoError(err)
+
+		input, err := GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), vote1Bytes, vote2Bytes)
+		g.Require().NoError(err)
+		return input
+	}
+	report := func(input []byte) error {
+		_, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		return err
+	}
+	setFineCapMultiple := func(multiple int64) error {
+		input, err := GovernanceABI.ABI.Pack("setFineCapMultiple", big.NewInt(multiple))
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		return err
+	}
+
+	lastFined := func() *big.Int {
+		logs := g.stateDB.Logs()
+		for i := len(logs) - 1; i >= 0; i-- {
+			if logs[i].Topics[0] == GovernanceABI.Events["Fined"].Id() {
+				return new(big.Int).SetBytes(logs[i].Data)
+			}
+		}
+		return nil
+	}
+
+	// The fine is capped at the stake and the event carries the applied amount.
+	g.Require().NoError(report(newEvidence()))
+	g.Require().Equal(amount.String(), g.s.Node(big.NewInt(0)).Fined.String())
+	g.Require().Equal(amount.String(), lastFined().String())
+	g.Require().Equal(amount.String(), g.s.TotalFined().String())
+
+	// A report that would apply nothing is rejected and does not consume its
+	// evidence.
+	input = newEvidence()
+	logCount := len(g.stateDB.Logs())
+	g.Require().Error(report(input))
+	g.Require().Len(g.stateDB.Logs(), logCount)
+	g.Require().Equal(amount.String(), g.s.Node(big.NewInt(0)).Fined.String())
+
+	// Raising the cap to a multiple of MinStake lets the same evidence fine
+	// up to the new cap.
+	g.Require().NoError(setFineCapMultiple(1))
+	g.Require().NoError(report(input))
+	g.Require().Equal(g.s.MinStake().String(), g.s.Node(big.NewInt(0)).Fined.String())
+	g.Require().Equal(new(big.Int).Sub(g.s.MinStake(), amount).String(), lastFined().String())
+	g.Require().Equal(g.s.MinStake().String(), g.s.TotalFined().String())
+
+	// Fines are not capped before the upgrade.
+	g.downgrade()
+	g.Require().Error(setFineCapMultiple(0))
+	g.Require().NoError(report(newEvidence()))
+	g.Require().Equal(new(big.Int).Add(g.s.MinStake(), g.s.FineValue(big.NewInt(FineTypeForkVote))).String(),
+		g.s.Node(big.NewInt(0)).Fined.String())
+}
+
+func (g *OracleContractsTestSuite) TestReportForkBlock() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+
+	// Stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei, Taiwan", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
+	block1 := &coreTypes.Block{
+		ProposerID: coreTypes.NewNodeID(privKey.PublicKey()),
+		ParentHash: coreCommon.NewRandomHash(),
+		Timestamp:  time.Now(),
+	}
+
+	block2 := block1.Clone()
+	for block2.ParentHash == block1.ParentHash {
+		block2.ParentHash = coreCommon.NewRandomHash()
+	}
+
+	hashBlock := func(block *coreTypes.Block) coreCommon.Hash {
+		block.PayloadHash = coreCrypto.Keccak256Hash(block.Payload)
+		var err error
+		block.Hash, err = coreUtils.HashBlock(block)
+		g.Require().NoError(err)
+		return block.Hash
+	}
+
+	block1.Signature, err = privKey.Sign(hashBlock(block1))
+	g.Require().NoError(err)
+	block2.Signature, err = privKey.Sign(hashBlock(block2))
+	g.Require().NoError(err)
+
+	block1Bytes, err := rlp.EncodeToBytes(block1)
+	g.Require().NoError(err)
+	block2Bytes, err := rlp.EncodeToBytes(block2)
+	g.Require().NoError(err)
+
+	// Report wrong type (fork vote)
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), block1Bytes, block2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), block1Bytes, block2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(node.Fined, g.s.FineValue(big.NewInt(FineTypeForkBlock)))
+
+	// Duplicate report should fail.
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), block1Bytes, block2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Check if finedRecords is set.
+	payloads := [][]byte{block1Bytes, block2Bytes}
+	sort.Sort(sortBytes(payloads))
+
+	hash := Bytes32(crypto.Keccak256Hash(payloads...))
+	input, err = GovernanceABI.ABI.Pack("finedRecords", hash)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	var value bool
+	err = GovernanceABI.ABI.Unpack(&value, "finedRecords", res)
+	g.Require().NoError(err)
+	g.Require().True(value)
+}
+
+func (g *OracleContractsTestSuite) TestReportSlashForkBlock() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+
+	// Stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei, Taiwan", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	privKey := coreEcdsa.NewPrivateKeyFromECDSA(key)
+	block1 := &coreTypes.Block{
+		ProposerID: coreTypes.NewNodeID(privKey.PublicKey()),
+		ParentHash: coreCommon.NewRandomHash(),
+		Timestamp:  time.Now(),
+	}
+	block2 := block1.Clone()
+	for block2.ParentHash == block1.ParentHash {
+		block2.ParentHash = coreCommon.NewRandomHash()
+	}
+	for _, block := range []*coreTypes.Block{block1, block2} {
+		block.PayloadHash = coreCrypto.Keccak256Hash(block.Payload)
+		block.Hash, err = coreUtils.HashBlock(block)
+		g.Require().NoError(err)
+		block.Signature, err = privKey.Sign(block.Hash)
+		g.Require().NoError(err)
+	}
+	block1Bytes, err := rlp.EncodeToBytes(block1)
+	g.Require().NoError(err)
+	block2Bytes, err := rlp.EncodeToBytes(block2)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeSlashForkBlock), block1Bytes, block2Bytes)
+	g.Require().NoError(err)
+
+	// Slashing is disabled without a configured value.
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	slashValue := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	g.s.SetFineValues(append(g.s.FineValues(), slashValue))
+
+	g.s.IncTotalSupply(amount)
+	totalStaked := g.s.TotalStaked()
+	totalSupply := g.s.TotalSupply()
+	contractBalance := g.stateDB.GetBalance(GovernanceContractAddress)
+
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(0, node.Staked.Cmp(new(big.Int).Sub(amount, slashValue)))
+	g.Require().Equal(0, node.Fined.Cmp(big.NewInt(0)))
+	g.Require().Equal(0, g.s.TotalStaked().Cmp(new(big.Int).Sub(totalStaked, slashValue)))
+	g.Require().Equal(0, g.s.TotalSupply().Cmp(new(big.Int).Sub(totalSupply, slashValue)))
+	g.Require().Equal(0, g.stateDB.GetBalance(GovernanceContractAddress).Cmp(
+		new(big.Int).Sub(contractBalance, slashValue)))
+
+	// Duplicate slash should fail.
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// The same evidence can still be fined.
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), block1Bytes, block2Bytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+}
+
+func (g *OracleContractsTestSuite) TestReportSlashRemovesNode() {
+	slashValue := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	g.s.SetFineValues(append(g.s.FineValues(), slashValue))
+	g.s.IncTotalSupply(new(big.Int).Mul(slashValue, big.NewInt(2)))
+
+	var keys []*ecdsa.PrivateKey
+	var addrs []common.Address
+	for i := 0; i < 2; i++ {
+		key, addr := newPrefundAccount(g.stateDB)
+		input, err := GovernanceABI.ABI.Pack("register", crypto.FromECDSAPub(&key.PublicKey),
+			"Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, slashValue)
+		g.Require().NoError(err)
+		keys = append(keys, key)
+		addrs = append(addrs, addr)
+	}
+
+	// Slash the first node out of all its stake.
+	privKey := coreEcdsa.NewPrivateKeyFromECDSA(keys[0])
+	block1 := &coreTypes.Block{
+		ProposerID: coreTypes.NewNodeID(privKey.PublicKey()),
+		ParentHash: coreCommon.NewRandomHash(),
+		Timestamp:  time.Now(),
+	}
+	block2 := block1.Clone()
+	for block2.ParentHash == block1.ParentHash {
+		block2.ParentHash = coreCommon.NewRandomHash()
+	}
+	var payloads [][]byte
+	for _, block := range []*coreTypes.Block{block1, block2} {
+		var err error
+		block.PayloadHash = coreCrypto.Keccak256Hash(block.Payload)
 		block.Hash, err = coreUtils.HashBlock(block)
 		g.Require().NoError(err)
-		return block.Hash
+		block.Signature, err = privKey.Sign(block.Hash)
+		g.Require().NoError(err)
+		b, err := rlp.EncodeToBytes(block)
+		g.Require().NoError(err)
+		payloads = append(payloads, b)
+	}
+	input, err := GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeSlashForkBlock), payloads[0], payloads[1])
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addrs[1], input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["NodeRemoved"].Id(), log.Topics[0])
+	g.Require().Equal(addrs[0].Hash(), log.Topics[1])
+
+	// The last node takes over the freed offset.
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(crypto.FromECDSAPub(&keys[0].PublicKey))
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), g.s.LenNodes().Int64())
+	g.Require().Equal(int64(-1), g.s.NodesOffsetByAddress(addrs[0]).Int64())
+	g.Require().Equal(int64(-1), g.s.NodesOffsetByNodeKeyAddress(nodeKeyAddr).Int64())
+	g.Require().Equal(int64(0), g.s.NodesOffsetByAddress(addrs[1]).Int64())
+	g.Require().Equal(addrs[1], g.s.Node(big.NewInt(0)).Owner)
+}
+
+func (g *OracleContractsTestSuite) TestReportInvalidDKG() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+
+	// Stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	reporterKey, reporterAddr := newPrefundAccount(g.stateDB)
+
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(key))
+	reporterSigner := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(reporterKey))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&key.PublicKey))
+	reporterID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&reporterKey.PublicKey))
+	round := g.s.DKGRound().Uint64()
+
+	// Store the master public key of the accused.
+	_, pubShares := cryptoDKG.NewPrivateKeyShares(2)
+	mpk := &dkgTypes.MasterPublicKey{
+		Round:           round,
+		DKGID:           dkgTypes.NewID(nodeID),
+		PublicKeyShares: *pubShares.Move(),
+	}
+	g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+	mpkBytes, err := rlp.EncodeToBytes(mpk)
+	g.Require().NoError(err)
+	g.s.PushDKGMasterPublicKey(mpkBytes)
+	g.s.PutDKGMasterPublicKeyOffset(Bytes32(nodeID.Hash), big.NewInt(0))
+
+	// A private share that does not match the master public key.
+	prvShare := &dkgTypes.PrivateShare{
+		ReceiverID:   reporterID,
+		Round:        round,
+		PrivateShare: *cryptoDKG.NewPrivateKey(),
+	}
+	g.Require().NoError(signer.SignDKGPrivateShare(prvShare))
+	comp := &dkgTypes.Complaint{
+		Round:        round,
+		PrivateShare: *prvShare,
+	}
+	g.Require().NoError(reporterSigner.SignDKGComplaint(comp))
+	compBytes, err := rlp.EncodeToBytes(comp)
+	g.Require().NoError(err)
+
+	// Complaint of another reset should fail.
+	badComp := *comp
+	badComp.Reset++
+	badComp.PrivateShare.Reset++
+	g.Require().NoError(signer.SignDKGPrivateShare(&badComp.PrivateShare))
+	g.Require().NoError(reporterSigner.SignDKGComplaint(&badComp))
+	badCompBytes, err := rlp.EncodeToBytes(&badComp)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeInvalidDKG), badCompBytes, []byte{})
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporterAddr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeInvalidDKG), compBytes, []byte{})
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporterAddr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	node := g.s.Node(big.NewInt(0))
+	g.Require().Equal(node.Fined, g.s.FineValue(big.NewInt(FineTypeInvalidDKG)))
+
+	// Duplicate report should fail.
+	_, err = g.call(GovernanceContractAddress, reporterAddr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestComplaintDuringCooldown() {
+	key, addr := newPrefundAccount(g.stateDB)
+	pkBytes := crypto.FromECDSAPub(&key.PublicKey)
+	reporterKey, reporterAddr := newPrefundAccount(g.stateDB)
+	reporterPkBytes := crypto.FromECDSAPub(&reporterKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pkBytes, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("register", reporterPkBytes, "Test2", "test2@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporterAddr, input, amount)
+	g.Require().NoError(err)
+
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(key))
+	reporterSigner := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(reporterKey))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&key.PublicKey))
+	reporterID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&reporterKey.PublicKey))
+
+	// Complaints are added for the DKG of the next round.
+	g.context.Round = big.NewInt(0)
+	g.s.SetDKGRound(big.NewInt(1))
+	round := uint64(1)
+
+	_, pubShares := cryptoDKG.NewPrivateKeyShares(2)
+	mpk := &dkgTypes.MasterPublicKey{
+		Round:           round,
+		DKGID:           dkgTypes.NewID(nodeID),
+		PublicKeyShares: *pubShares.Move(),
+	}
+	g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+	mpkBytes, err := rlp.EncodeToBytes(mpk)
+	g.Require().NoError(err)
+	g.s.PushDKGMasterPublicKey(mpkBytes)
+	g.s.PutDKGMasterPublicKeyOffset(Bytes32(nodeID.Hash), big.NewInt(0))
+
+	// A private share that does not match the master public key.
+	prvShare := &dkgTypes.PrivateShare{
+		ReceiverID:   reporterID,
+		Round:        round,
+		PrivateShare: *cryptoDKG.NewPrivateKey(),
+	}
+	g.Require().NoError(signer.SignDKGPrivateShare(prvShare))
+	comp := &dkgTypes.Complaint{
+		Round:        round,
+		PrivateShare: *prvShare,
+	}
+	g.Require().NoError(reporterSigner.SignDKGComplaint(comp))
+	compBytes, err := rlp.EncodeToBytes(comp)
+	g.Require().NoError(err)
+
+	// The accused was just fined, so the complaint is recorded unfined.
+	g.s.SetReportCooldown(big.NewInt(1e9))
+	g.s.PutLastFinedAt(addr, big.NewInt(FineTypeInvalidDKG), big.NewInt(time.Now().UnixNano()/1000000))
+	input, err = GovernanceABI.ABI.Pack("addDKGComplaint", compBytes)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporterAddr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), g.s.LenDKGComplaints().Int64())
+	g.Require().Equal(0, g.s.Node(big.NewInt(0)).Fined.Sign())
+
+	// The same complaint can be reported once the cooldown is over.
+	g.s.PutLastFinedAt(addr, big.NewInt(FineTypeInvalidDKG), big.NewInt(1))
+	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeInvalidDKG), compBytes, []byte{})
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, reporterAddr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(g.s.FineValue(big.NewInt(FineTypeInvalidDKG)), g.s.Node(big.NewInt(0)).Fined)
+}
+
+func (g *OracleContractsTestSuite) TestMiscVariableReading() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	input, err := GovernanceABI.ABI.Pack("totalSupply")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("totalStaked")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// Stake.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	input, err = GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei, Taiwan", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("nodes", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("nodesLength")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var value *big.Int
+	err = GovernanceABI.ABI.Unpack(&value, "nodesLength", res)
+	g.Require().NoError(err)
+	g.Require().Equal(1, int(value.Uint64()))
+
+	input, err = GovernanceABI.ABI.Pack("nodesOffsetByAddress", addr)
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "nodesOffsetByAddress", res)
+	g.Require().NoError(err)
+	g.Require().Equal(0, int(value.Uint64()))
+
+	addr, err = publicKeyToNodeKeyAddress(pk)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("nodesOffsetByNodeKeyAddress", addr)
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "nodesOffsetByNodeKeyAddress", res)
+	g.Require().NoError(err)
+	g.Require().Equal(0, int(value.Uint64()))
+
+	input, err = GovernanceABI.ABI.Pack("fineValues", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("fineValuesLength")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&value, "fineValuesLength", res)
+	g.Require().NoError(err)
+	g.Require().Equal(len(g.config.FineValues), int(value.Uint64()))
+}
+
+func (g *OracleContractsTestSuite) TestNodesByNodeKeyAddress() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("nodesByNodeKeyAddress", big.NewInt(0), nodeKeyAddr)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	info := struct {
+		Owner      common.Address
+		PublicKey  []byte
+		Staked     *big.Int
+		Fined      *big.Int
+		Name       string
+		Email      string
+		Location   string
+		Url        string
+		Unstaked   *big.Int
+		UnstakedAt *big.Int
+	}{}
+	err = GovernanceABI.ABI.Unpack(&info, "nodesByNodeKeyAddress", res)
+	g.Require().NoError(err)
+	g.Require().Equal(addr, info.Owner)
+	g.Require().Equal(pk, info.PublicKey)
+	g.Require().Equal(amount.String(), info.Staked.String())
+	g.Require().Equal("Test1", info.Name)
+
+	// Unknown node key address should fail.
+	_, unknown := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("nodesByNodeKeyAddress", big.NewInt(0), unknown)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Round with unknown height should fail.
+	input, err = GovernanceABI.ABI.Pack("nodesByNodeKeyAddress", big.NewInt(100), nodeKeyAddr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestNodeByAddress() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	// Occupy offset 0 with another node.
+	otherKey, other := newPrefundAccount(g.stateDB)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	input, err := GovernanceABI.ABI.Pack("register", crypto.FromECDSAPub(&otherKey.PublicKey),
+		"Test0", "test0@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, other, input, amount)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	for _, method := range []string{"nodeByAddress", "roundNodeByAddress"} {
+		pack := func(address common.Address) []byte {
+			var input []byte
+			var err error
+			if method == "nodeByAddress" {
+				input, err = GovernanceABI.ABI.Pack(method, address)
+			} else {
+				input, err = GovernanceABI.ABI.Pack(method, big.NewInt(0), address)
+			}
+			g.Require().NoError(err)
+			return input
+		}
+
+		res, err := g.call(GovernanceContractAddress, addr, pack(addr), big.NewInt(0))
+		g.Require().NoError(err)
+		info := struct {
+			Owner      common.Address
+			PublicKey  []byte
+			Staked     *big.Int
+			Fined      *big.Int
+			Name       string
+			Email      string
+			Location   string
+			Url        string
+			Unstaked   *big.Int
+			UnstakedAt *big.Int
+			Offset     *big.Int
+		}{}
+		err = GovernanceABI.ABI.Unpack(&info, method, res)
+		g.Require().NoError(err)
+		g.Require().Equal(addr, info.Owner)
+		g.Require().Equal(pk, info.PublicKey)
+		g.Require().Equal(amount.String(), info.Staked.String())
+		g.Require().Equal("Test1", info.Name)
+		g.Require().Equal(int64(1), info.Offset.Int64())
+
+		// Unknown node should fail.
+		_, unknown := newPrefundAccount(g.stateDB)
+		_, err = g.call(GovernanceContractAddress, addr, pack(unknown), big.NewInt(0))
+		g.Require().Error(err)
+	}
+
+	// Round with unknown height should fail.
+	input, err = GovernanceABI.ABI.Pack("roundNodeByAddress", big.NewInt(100), addr)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestNodesPaginated() {
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	var owners []common.Address
+	for i := 0; i < 3; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
+		owners = append(owners, addr)
+	}
+
+	page := struct {
+		Owners     []common.Address
+		PublicKeys [][]byte
+		Staked     []*big.Int
+		Fined      []*big.Int
+		Names      []string
+		Emails     []string
+		Locations  []string
+		Urls       []string
+		Unstaked   []*big.Int
+		UnstakedAt []*big.Int
+	}{}
+
+	input, err := GovernanceABI.ABI.Pack("nodesPaginated", big.NewInt(0), big.NewInt(1), big.NewInt(10))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, owners[0], input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&page, "nodesPaginated", res)
+	g.Require().NoError(err)
+	g.Require().Equal(owners[1:], page.Owners)
+	g.Require().Len(page.PublicKeys, 2)
+	g.Require().Equal(0, page.Staked[0].Cmp(amount))
+	g.Require().Equal("Test", page.Names[1])
+
+	// Offset past the end returns empty arrays.
+	input, err = GovernanceABI.ABI.Pack("nodesPaginated", big.NewInt(0), big.NewInt(5), big.NewInt(10))
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, owners[0], input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&page, "nodesPaginated", res)
+	g.Require().NoError(err)
+	g.Require().Len(page.Owners, 0)
+
+	// Round with unknown height should fail.
+	input, err = GovernanceABI.ABI.Pack("nodesPaginated", big.NewInt(100), big.NewInt(0), big.NewInt(10))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, owners[0], input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestNodeExists() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
+	g.Require().NoError(err)
+
+	exists := func(name string, address common.Address) bool {
+		input, err := GovernanceABI.ABI.Pack(name, address)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		var value bool
+		err = GovernanceABI.ABI.Unpack(&value, name, res)
+		g.Require().NoError(err)
+		return value
+	}
+
+	g.Require().False(exists("nodeExists", addr))
+	g.Require().False(exists("nodeExistsByNodeKeyAddress", nodeKeyAddr))
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e5))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	g.Require().True(exists("nodeExists", addr))
+	g.Require().True(exists("nodeExistsByNodeKeyAddress", nodeKeyAddr))
+}
+
+func (g *OracleContractsTestSuite) TestFinedNodes() {
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+	var owners []common.Address
+	for i := 0; i < 3; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
+		owners = append(owners, addr)
+	}
+
+	fine := big.NewInt(1e18)
+	for _, i := range []int64{0, 2} {
+		node := g.s.Node(big.NewInt(i))
+		node.Fined = new(big.Int).Mul(fine, big.NewInt(i+1))
+		g.s.UpdateNode(big.NewInt(i), node)
+	}
+
+	page := struct {
+		Owners []common.Address
+		Fined  []*big.Int
+	}{}
+	input, err := GovernanceABI.ABI.Pack("finedNodes", big.NewInt(0), big.NewInt(10))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, owners[0], input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&page, "finedNodes", res)
+	g.Require().NoError(err)
+	g.Require().Equal([]common.Address{owners[0], owners[2]}, page.Owners)
+	g.Require().Equal(fine.String(), page.Fined[0].String())
+	g.Require().Equal(new(big.Int).Mul(fine, big.NewInt(3)).String(), page.Fined[1].String())
+
+	// The window only covers the scanned range.
+	input, err = GovernanceABI.ABI.Pack("finedNodes", big.NewInt(1), big.NewInt(1))
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, owners[0], input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&page, "finedNodes", res)
+	g.Require().NoError(err)
+	g.Require().Len(page.Owners, 0)
+}
+
+func (g *OracleContractsTestSuite) TestIsInDKGSet() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("isInDKGSet", big.NewInt(0), nodeKeyAddr)
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var in bool
+	err = GovernanceABI.ABI.Unpack(&in, "isInDKGSet", res)
+	g.Require().NoError(err)
+	g.Require().True(in)
+
+	// Unknown node key address.
+	_, unknown := newPrefundAccount(g.stateDB)
+	input, err = GovernanceABI.ABI.Pack("isInDKGSet", big.NewInt(0), unknown)
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	err = GovernanceABI.ABI.Unpack(&in, "isInDKGSet", res)
+	g.Require().NoError(err)
+	g.Require().False(in)
+}
+
+func (g *OracleContractsTestSuite) TestDuplicatedDKGMasterPublicKey() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	g.context.Round = big.NewInt(0)
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey.PublicKey))
+	newMPK := func() []byte {
+		_, pubShares := cryptoDKG.NewPrivateKeyShares(1)
+		mpk := &dkgTypes.MasterPublicKey{
+			Round:           1,
+			DKGID:           dkgTypes.NewID(nodeID),
+			PublicKeyShares: *pubShares.Move(),
+		}
+		g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+		b, err := rlp.EncodeToBytes(mpk)
+		g.Require().NoError(err)
+		return b
+	}
+
+	input, err = GovernanceABI.ABI.Pack("addDKGMasterPublicKey", newMPK())
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), g.s.LenDKGMasterPublicKeys().Int64())
+
+	// A second MPK from the same proposer is rejected.
+	input, err = GovernanceABI.ABI.Pack("addDKGMasterPublicKey", newMPK())
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().Equal(int64(1), g.s.LenDKGMasterPublicKeys().Int64())
+}
+
+func (g *OracleContractsTestSuite) TestDKGEvents() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	// The complainer.
+	privKey2, addr2 := newPrefundAccount(g.stateDB)
+	pk2 := crypto.FromECDSAPub(&privKey2.PublicKey)
+	input, err = GovernanceABI.ABI.Pack("register", pk2, "Test2", "test2@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr2, input, amount)
+	g.Require().NoError(err)
+
+	g.context.Round = big.NewInt(0)
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey))
+	signer2 := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey2))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey.PublicKey))
+	nodeID2 := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey2.PublicKey))
+
+	emitted := func(name string, proposer common.Address) bool {
+		for _, log := range g.stateDB.Logs() {
+			if log.Topics[0] == GovernanceABI.Events[name].Id() {
+				g.Require().Len(log.Topics, 2)
+				g.Require().Equal(proposer.Hash(), log.Topics[1])
+				return true
+			}
+		}
+		return false
+	}
+	submit := func(method string, data []byte, caller common.Address) {
+		input, err := GovernanceABI.ABI.Pack(method, data)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, caller, input, big.NewInt(0))
+		g.Require().NoError(err)
+	}
+
+	_, pubShares := cryptoDKG.NewPrivateKeyShares(1)
+	mpk := &dkgTypes.MasterPublicKey{
+		Round:           1,
+		DKGID:           dkgTypes.NewID(nodeID),
+		PublicKeyShares: *pubShares.Move(),
+	}
+	g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+	b, err := rlp.EncodeToBytes(mpk)
+	g.Require().NoError(err)
+	g.Require().False(emitted("DKGMasterPublicKeyAdded", addr))
+	submit("addDKGMasterPublicKey", b, addr)
+	g.Require().True(emitted("DKGMasterPublicKeyAdded", addr))
+
+	// A private share that does not match the master public key.
+	prvShare := &dkgTypes.PrivateShare{
+		ReceiverID:   nodeID2,
+		Round:        1,
+		PrivateShare: *cryptoDKG.NewPrivateKey(),
+	}
+	g.Require().NoError(signer.SignDKGPrivateShare(prvShare))
+	comp := &dkgTypes.Complaint{
+		Round:        1,
+		PrivateShare: *prvShare,
+	}
+	g.Require().NoError(signer2.SignDKGComplaint(comp))
+	b, err = rlp.EncodeToBytes(comp)
+	g.Require().NoError(err)
+	g.Require().False(emitted("DKGComplaintAdded", addr2))
+	submit("addDKGComplaint", b, addr2)
+	g.Require().True(emitted("DKGComplaintAdded", addr2))
+
+	// The accused got fined out of the DKG set, the complainer continues.
+	ready := &dkgTypes.MPKReady{Round: 1}
+	g.Require().NoError(signer2.SignDKGMPKReady(ready))
+	b, err = rlp.EncodeToBytes(ready)
+	g.Require().NoError(err)
+	g.Require().False(emitted("DKGReadyAdded", addr2))
+	submit("addDKGMPKReady", b, addr2)
+	g.Require().True(emitted("DKGReadyAdded", addr2))
+
+	final := &dkgTypes.Finalize{Round: 1}
+	g.Require().NoError(signer2.SignDKGFinalize(final))
+	b, err = rlp.EncodeToBytes(final)
+	g.Require().NoError(err)
+	g.Require().False(emitted("DKGFinalizeAdded", addr2))
+	submit("addDKGFinalize", b, addr2)
+	g.Require().True(emitted("DKGFinalizeAdded", addr2))
+}
+
+func (g *OracleContractsTestSuite) TestAutoGasPrice() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	// Auto adjustment can not be enabled before bounds are set.
+	input, err := GovernanceABI.ABI.Pack("setAutoGasPrice", true)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	// Only owner can set the bounds, and they must be a valid range.
+	input, err = GovernanceABI.ABI.Pack("setMinGasPriceBounds", big.NewInt(10), big.NewInt(1e12))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	for _, bounds := range [][2]int64{{0, 10}, {10, 9}} {
+		input, err := GovernanceABI.ABI.Pack("setMinGasPriceBounds",
+			big.NewInt(bounds[0]), big.NewInt(bounds[1]))
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		g.Require().Error(err)
+	}
+
+	input, err = GovernanceABI.ABI.Pack("minGasPriceBounds")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	bounds := struct {
+		Min *big.Int
+		Max *big.Int
+	}{}
+	err = GovernanceABI.ABI.Unpack(&bounds, "minGasPriceBounds", res)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(10), bounds.Min.Int64())
+	g.Require().Equal(int64(1e12), bounds.Max.Int64())
+
+	// Only owner can enable auto adjustment.
+	input, err = GovernanceABI.ABI.Pack("setAutoGasPrice", true)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("autoGasPrice")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var enabled bool
+	err = GovernanceABI.ABI.Unpack(&enabled, "autoGasPrice", res)
+	g.Require().NoError(err)
+	g.Require().True(enabled)
+}
+
+func (g *OracleContractsTestSuite) TestDKGComplaintLimits() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	privKey2, addr2 := newPrefundAccount(g.stateDB)
+	pk2 := crypto.FromECDSAPub(&privKey2.PublicKey)
+	input, err = GovernanceABI.ABI.Pack("register", pk2, "Test2", "test2@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr2, input, amount)
+	g.Require().NoError(err)
+
+	g.context.Round = big.NewInt(0)
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey))
+	signer2 := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey2))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey.PublicKey))
+	nodeID2 := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey2.PublicKey))
+
+	_, pubShares := cryptoDKG.NewPrivateKeyShares(1)
+	mpk := &dkgTypes.MasterPublicKey{
+		Round:           1,
+		DKGID:           dkgTypes.NewID(nodeID),
+		PublicKeyShares: *pubShares.Move(),
+	}
+	g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+	b, err := rlp.EncodeToBytes(mpk)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("addDKGMasterPublicKey", b)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	complain := func(comp *dkgTypes.Complaint) ([]byte, error) {
+		g.Require().NoError(signer2.SignDKGComplaint(comp))
+		b, err := rlp.EncodeToBytes(comp)
+		g.Require().NoError(err)
+		input, err := GovernanceABI.ABI.Pack("addDKGComplaint", b)
+		g.Require().NoError(err)
+		return g.call(GovernanceContractAddress, addr2, input, big.NewInt(0))
+	}
+	nack := &dkgTypes.Complaint{
+		Round:        1,
+		PrivateShare: dkgTypes.PrivateShare{ProposerID: nodeID, Round: 1},
+	}
+
+	// Only owner can set the limit.
+	input, err = GovernanceABI.ABI.Pack("setMaxDKGComplaints", big.NewInt(1))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	_, err = complain(nack)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), g.s.LenDKGComplaints().Int64())
+
+	// The same complaint is rejected.
+	_, err = complain(nack)
+	g.Require().Error(err)
+
+	// A different complaint is rejected once the limit is reached.
+	prvShare := &dkgTypes.PrivateShare{
+		ReceiverID:   nodeID2,
+		Round:        1,
+		PrivateShare: *cryptoDKG.NewPrivateKey(),
+	}
+	g.Require().NoError(signer.SignDKGPrivateShare(prvShare))
+	comp := &dkgTypes.Complaint{
+		Round:        1,
+		PrivateShare: *prvShare,
+	}
+	res, err := complain(comp)
+	g.Require().Error(err)
+	var reason string
+	g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+	g.Require().Equal("too many complaints", reason)
+	g.Require().Equal(int64(1), g.s.LenDKGComplaints().Int64())
+
+	// Zero restores the default limit, derived from the DKG set size.
+	input, err = GovernanceABI.ABI.Pack("setMaxDKGComplaints", big.NewInt(0))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("maxDKGComplaints")
+	g.Require().NoError(err)
+	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	limit := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&limit, "maxDKGComplaints", res)
+	g.Require().NoError(err)
+	size := g.s.NotarySetSize()
+	g.Require().Equal(new(big.Int).Mul(big.NewInt(2), new(big.Int).Mul(size, size)).String(), limit.String())
+
+	_, err = complain(comp)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(2), g.s.LenDKGComplaints().Int64())
+}
+
+func (g *OracleContractsTestSuite) TestDKGComplaintGas() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	privKey2, addr2 := newPrefundAccount(g.stateDB)
+	pk2 := crypto.FromECDSAPub(&privKey2.PublicKey)
+	input, err = GovernanceABI.ABI.Pack("register", pk2, "Test2", "test2@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr2, input, amount)
+	g.Require().NoError(err)
+
+	g.Require().Equal(int64(GovernanceActionGasCost), g.s.DKGComplaintGas().Int64())
+
+	// Only owner can set the gas, and zero is rejected.
+	complaintGas := big.NewInt(300000)
+	input, err = GovernanceABI.ABI.Pack("setDKGComplaintGas", complaintGas)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("setDKGComplaintGas", big.NewInt(0))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().Equal(complaintGas.String(), g.s.DKGComplaintGas().String())
+
+	g.context.Round = big.NewInt(0)
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey))
+	signer2 := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey2))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey.PublicKey))
+	nodeID2 := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey2.PublicKey))
+
+	_, pubShares := cryptoDKG.NewPrivateKeyShares(1)
+	mpk := &dkgTypes.MasterPublicKey{
+		Round:           1,
+		DKGID:           dkgTypes.NewID(nodeID),
+		PublicKeyShares: *pubShares.Move(),
+	}
+	g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+	b, err := rlp.EncodeToBytes(mpk)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("addDKGMasterPublicKey", b)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	prvShare := &dkgTypes.PrivateShare{
+		ReceiverID:   nodeID2,
+		Round:        1,
+		PrivateShare: *cryptoDKG.NewPrivateKey(),
+	}
+	g.Require().NoError(signer.SignDKGPrivateShare(prvShare))
+	comp := &dkgTypes.Complaint{
+		Round:        1,
+		PrivateShare: *prvShare,
+	}
+	g.Require().NoError(signer2.SignDKGComplaint(comp))
+	b, err = rlp.EncodeToBytes(comp)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("addDKGComplaint", b)
+	g.Require().NoError(err)
+
+	gas := uint64(10000000)
+	evm := NewEVM(g.context, g.stateDB, params.TestChainConfig, Config{IsBlockProposer: true})
+	_, leftOver, err := evm.Call(AccountRef(addr2), GovernanceContractAddress, input, gas, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(complaintGas.Uint64(), gas-leftOver)
+}
+
+func (g *OracleContractsTestSuite) TestDKGEventsBeforeUpgrade() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	g.downgrade()
+	g.context.Round = big.NewInt(0)
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey))
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey.PublicKey))
+
+	_, pubShares := cryptoDKG.NewPrivateKeyShares(1)
+	mpk := &dkgTypes.MasterPublicKey{
+		Round:           1,
+		DKGID:           dkgTypes.NewID(nodeID),
+		PublicKeyShares: *pubShares.Move(),
+	}
+	g.Require().NoError(signer.SignDKGMasterPublicKey(mpk))
+	b, err := rlp.EncodeToBytes(mpk)
+	g.Require().NoError(err)
+	input, err = GovernanceABI.ABI.Pack("addDKGMasterPublicKey", b)
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), g.s.LenDKGMasterPublicKeys().Int64())
+
+	for _, log := range g.stateDB.Logs() {
+		g.Require().NotEqual(GovernanceABI.Events["DKGMasterPublicKeyAdded"].Id(), log.Topics[0])
+	}
+}
+
+func (g *OracleContractsTestSuite) TestConfigRoundFor() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	shift := dexCore.ConfigRoundShift
+	for _, round := range []uint64{0, shift, shift + 1, shift + 10} {
+		input, err := GovernanceABI.ABI.Pack("configRoundFor", new(big.Int).SetUint64(round))
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+
+		var value = new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&value, "configRoundFor", res)
+		g.Require().NoError(err)
+
+		expected := uint64(0)
+		if round > shift {
+			expected = round - shift
+		}
+		g.Require().Equal(expected, value.Uint64())
+	}
+}
+
+func (g *OracleContractsTestSuite) TestDKGComplaintsSummary() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	var complainers, accused []coreTypes.NodeID
+	for i := 0; i < 2; i++ {
+		comp := &dkgTypes.Complaint{
+			ProposerID: coreTypes.NodeID{Hash: coreCommon.NewRandomHash()},
+			PrivateShare: dkgTypes.PrivateShare{
+				ProposerID: coreTypes.NodeID{Hash: coreCommon.NewRandomHash()},
+			},
+		}
+		b, err := rlp.EncodeToBytes(comp)
+		g.Require().NoError(err)
+		g.s.PushDKGComplaint(b)
+		complainers = append(complainers, comp.ProposerID)
+		accused = append(accused, comp.PrivateShare.ProposerID)
+
+		// Malformed entries are skipped.
+		g.s.PushDKGComplaint([]byte{0xbe, 0xef})
+	}
+
+	input, err := GovernanceABI.ABI.Pack("dkgComplaintsSummary")
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	summary := struct {
+		Complainers [][32]byte
+		Accused     [][32]byte
+	}{}
+	err = GovernanceABI.ABI.Unpack(&summary, "dkgComplaintsSummary", res)
+	g.Require().NoError(err)
+	g.Require().Len(summary.Complainers, 2)
+	g.Require().Len(summary.Accused, 2)
+	for i := range complainers {
+		g.Require().Equal([32]byte(complainers[i].Hash), summary.Complainers[i])
+		g.Require().Equal([32]byte(accused[i].Hash), summary.Accused[i])
+	}
+}
+
+func (g *OracleContractsTestSuite) TestDKGResetCounts() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	g.s.IncDKGResetCount(big.NewInt(2))
+	g.s.IncDKGResetCount(big.NewInt(2))
+	g.s.IncDKGResetCount(big.NewInt(4))
+
+	input, err := GovernanceABI.ABI.Pack("dkgResetCounts", big.NewInt(1), big.NewInt(5))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	var counts []*big.Int
+	err = GovernanceABI.ABI.Unpack(&counts, "dkgResetCounts", res)
+	g.Require().NoError(err)
+	g.Require().Len(counts, 5)
+	for i, expected := range []int64{0, 2, 0, 1, 0} {
+		g.Require().Equal(expected, counts[i].Int64())
+	}
+
+	// Reversed and oversized ranges should fail.
+	input, err = GovernanceABI.ABI.Pack("dkgResetCounts", big.NewInt(5), big.NewInt(1))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+
+	input, err = GovernanceABI.ABI.Pack("dkgResetCounts", big.NewInt(0), big.NewInt(maxDKGResetCountsSpan))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestDKGThreshold() {
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	for i := 0; i < 7; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
+	}
+
+	_, addr := newPrefundAccount(g.stateDB)
+	input, err := GovernanceABI.ABI.Pack("dkgThreshold", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	value := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "dkgThreshold", res)
+	g.Require().NoError(err)
+
+	// 2f + 1 of a set of 7.
+	g.Require().Equal(int64(7), g.s.NotarySetSize().Int64())
+	g.Require().Equal(int64(5), value.Int64())
+}
+
+func (g *OracleContractsTestSuite) TestNotarySetClamped() {
+	var nodeKeyAddrs []common.Address
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	for i := 0; i < 3; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
+
+		nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
+		g.Require().NoError(err)
+		nodeKeyAddrs = append(nodeKeyAddrs, nodeKeyAddr)
+	}
+	g.Require().True(g.s.NotarySetSize().Cmp(big.NewInt(3)) > 0)
+
+	// All qualified nodes are in the set when there are fewer of them than
+	// the configured size, GetSubSet never pads the set.
+	_, addr := newPrefundAccount(g.stateDB)
+	input, err := GovernanceABI.ABI.Pack("notarySet", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var set []common.Address
+	err = GovernanceABI.ABI.Unpack(&set, "notarySet", res)
+	g.Require().NoError(err)
+	sort.Slice(nodeKeyAddrs, func(i, j int) bool {
+		return bytes.Compare(nodeKeyAddrs[i][:], nodeKeyAddrs[j][:]) < 0
+	})
+	g.Require().Equal(nodeKeyAddrs, set)
+}
+
+func (g *OracleContractsTestSuite) TestRoundHeights() {
+	_, addr := newPrefundAccount(g.stateDB)
+	call := func(method string, args ...interface{}) ([]byte, error) {
+		input, err := GovernanceABI.ABI.Pack(method, args...)
+		g.Require().NoError(err)
+		return g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	}
+
+	for _, height := range []int64{10, 20, 30} {
+		g.s.PushRoundHeight(big.NewInt(height))
+	}
+
+	// Round 0 is recorded at genesis.
+	res, err := call("roundHeightsLength")
+	g.Require().NoError(err)
+	length := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&length, "roundHeightsLength", res)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(4), length.Int64())
+
+	res, err = call("roundHeights", big.NewInt(1), big.NewInt(3))
+	g.Require().NoError(err)
+	var heights []*big.Int
+	err = GovernanceABI.ABI.Unpack(&heights, "roundHeights", res)
+	g.Require().NoError(err)
+	g.Require().Len(heights, 3)
+	for i, height := range []int64{10, 20, 30} {
+		g.Require().Equal(height, heights[i].Int64())
+	}
+
+	// Unrecorded, reversed and too wide ranges are rejected.
+	for _, r := range [][2]int64{{1, 4}, {2, 1}, {0, maxRoundHeightsSpan}} {
+		_, err = call("roundHeights", big.NewInt(r[0]), big.NewInt(r[1]))
+		g.Require().Error(err)
+	}
+}
+
+func (g *OracleContractsTestSuite) TestNodesOutOfRange() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("nodes", big.NewInt(0))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	// Reading past the end reverts instead of returning an empty node.
+	input, err = GovernanceABI.ABI.Pack("nodes", big.NewInt(1))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestQualifiedNodes() {
+	_, addr := newPrefundAccount(g.stateDB)
+	qualifiedNodes := func() []common.Address {
+		input, err := GovernanceABI.ABI.Pack("qualifiedNodes", big.NewInt(0))
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		var set []common.Address
+		err = GovernanceABI.ABI.Unpack(&set, "qualifiedNodes", res)
+		g.Require().NoError(err)
+		return set
+	}
+
+	// No node qualifies yet.
+	g.Require().Len(qualifiedNodes(), 0)
+
+	var nodeKeyAddrs []common.Address
+	for i := 0; i < 3; i++ {
+		privKey, nodeAddr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		amount := g.s.MinStake()
+		// The last node does not stake enough to qualify.
+		if i == 2 {
+			amount = new(big.Int).Sub(amount, big.NewInt(1))
+		}
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, nodeAddr, input, amount)
+		g.Require().NoError(err)
+
+		nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
+		g.Require().NoError(err)
+		nodeKeyAddrs = append(nodeKeyAddrs, nodeKeyAddr)
+	}
+	g.Require().Equal(nodeKeyAddrs[:2], qualifiedNodes())
+
+	// A fined node is no longer qualified.
+	node := g.s.Node(big.NewInt(0))
+	node.Fined = big.NewInt(1)
+	g.s.UpdateNode(big.NewInt(0), node)
+	g.Require().Equal(nodeKeyAddrs[1:2], qualifiedNodes())
+}
+
+func (g *OracleContractsTestSuite) TestNotarySet() {
+	var nodeKeyAddrs []common.Address
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	for i := 0; i < 4; i++ {
+		privKey, addr := newPrefundAccount(g.stateDB)
+		pk := crypto.FromECDSAPub(&privKey.PublicKey)
+		input, err := GovernanceABI.ABI.Pack("register", pk, "Test", "test@dexon.org", "Taipei", "https://dexon.org")
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, amount)
+		g.Require().NoError(err)
+
+		nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
+		g.Require().NoError(err)
+		nodeKeyAddrs = append(nodeKeyAddrs, nodeKeyAddr)
+	}
+	g.s.CalNotarySetSize()
+
+	_, addr := newPrefundAccount(g.stateDB)
+	input, err := GovernanceABI.ABI.Pack("notarySet", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	var set []common.Address
+	err = GovernanceABI.ABI.Unpack(&set, "notarySet", res)
+	g.Require().NoError(err)
+	g.Require().Len(set, int(g.s.NotarySetSize().Uint64()))
+	g.Require().True(sort.SliceIsSorted(set, func(i, j int) bool {
+		return bytes.Compare(set[i][:], set[j][:]) < 0
+	}))
+
+	inSet := map[common.Address]struct{}{}
+	for _, a := range set {
+		inSet[a] = struct{}{}
+	}
+	for _, nodeKeyAddr := range nodeKeyAddrs {
+		input, err = GovernanceABI.ABI.Pack("isInNotarySet", big.NewInt(0), nodeKeyAddr)
+		g.Require().NoError(err)
+		res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		var in bool
+		err = GovernanceABI.ABI.Unpack(&in, "isInNotarySet", res)
+		g.Require().NoError(err)
+		_, expected := inSet[nodeKeyAddr]
+		g.Require().Equal(expected, in)
+	}
+}
+
+func (g *OracleContractsTestSuite) TestRoundCRS() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	crs := g.s.CRS()
+	for i := uint64(0); i <= dexCore.DKGDelayRound; i++ {
+		input, err := GovernanceABI.ABI.Pack("roundCRS", new(big.Int).SetUint64(i))
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		var value [32]byte
+		err = GovernanceABI.ABI.Unpack(&value, "roundCRS", res)
+		g.Require().NoError(err)
+		g.Require().Equal(crs, common.Hash(value))
+		crs = crypto.Keccak256Hash(crs[:])
+	}
+
+	// Round without CRS should fail.
+	input, err := GovernanceABI.ABI.Pack("roundCRS", big.NewInt(int64(dexCore.DKGDelayRound+1)))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestRoundMinGasPrice() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	input, err := GovernanceABI.ABI.Pack("roundMinGasPrice", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	var value = new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "roundMinGasPrice", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.s.MinGasPrice().String(), value.String())
+
+	// Round with unknown height should fail.
+	input, err = GovernanceABI.ABI.Pack("roundMinGasPrice", big.NewInt(100))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestRoundLockupPeriod() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	input, err := GovernanceABI.ABI.Pack("roundLockupPeriod", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	var value = new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "roundLockupPeriod", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.s.LockupPeriod().String(), value.String())
+
+	// Round with unknown height should fail.
+	input, err = GovernanceABI.ABI.Pack("roundLockupPeriod", big.NewInt(100))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestValidateBlockInterval() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	interval := g.s.MinBlockInterval().Int64()
+	g.Require().True(interval > 0)
+	parent := int64(1e12)
+	for _, c := range []struct {
+		blockTime int64
+		valid     bool
+	}{
+		{parent + interval - 1, false},
+		{parent + interval, true},
+		{parent + 2*interval, true},
+		{parent - 1, false},
+	} {
+		input, err := GovernanceABI.ABI.Pack("validateBlockInterval", big.NewInt(parent), big.NewInt(c.blockTime))
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+
+		var valid bool
+		err = GovernanceABI.ABI.Unpack(&valid, "validateBlockInterval", res)
+		g.Require().NoError(err)
+		g.Require().Equal(c.valid, valid)
+	}
+}
+
+func (g *OracleContractsTestSuite) TestRoundProgress() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	progress := struct {
+		RoundHeight   *big.Int
+		CurrentHeight *big.Int
+		RoundLength   *big.Int
+	}{}
+	query := func() {
+		input, err := GovernanceABI.ABI.Pack("roundProgress")
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		err = GovernanceABI.ABI.Unpack(&progress, "roundProgress", res)
+		g.Require().NoError(err)
+	}
+
+	// Genesis round.
+	g.context.Round = big.NewInt(0)
+	g.context.BlockNumber = big.NewInt(10)
+	query()
+	g.Require().Equal(uint64(0), progress.RoundHeight.Uint64())
+	g.Require().Equal(uint64(10), progress.CurrentHeight.Uint64())
+	g.Require().Equal(g.s.RoundLength().String(), progress.RoundLength.String())
+
+	// Next round.
+	g.s.PushRoundHeight(big.NewInt(1000))
+	g.context.Round = big.NewInt(1)
+	g.context.BlockNumber = big.NewInt(1200)
+	query()
+	g.Require().Equal(uint64(1000), progress.RoundHeight.Uint64())
+	g.Require().Equal(uint64(1200), progress.CurrentHeight.Uint64())
+	g.Require().Equal(g.s.RoundLength().String(), progress.RoundLength.String())
+}
+
+func (g *OracleContractsTestSuite) TestRoundTotalStaked() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
+	g.Require().NoError(err)
+
+	input, err = GovernanceABI.ABI.Pack("roundTotalStaked", big.NewInt(0))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	var value = new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "roundTotalStaked", res)
+	g.Require().NoError(err)
+	g.Require().Equal(g.s.TotalStaked().String(), value.String())
+
+	// Round with unknown height should fail.
+	input, err = GovernanceABI.ABI.Pack("roundTotalStaked", big.NewInt(100))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+}
+
+func (g *OracleContractsTestSuite) TestHalvingCondition() {
+	// TotalSupply 2.5B reached
+	g.s.MiningHalved()
+	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(3.25e9)).String(),
+		g.s.NextHalvingSupply().String())
+	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(0.75e9)).String(),
+		g.s.LastHalvedAmount().String())
+
+	// TotalSupply 3.25B reached
+	g.s.MiningHalved()
+	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(3.625e9)).String(),
+		g.s.NextHalvingSupply().String())
+	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(0.375e9)).String(),
+		g.s.LastHalvedAmount().String())
+}
+
+func (g *OracleContractsTestSuite) TestMiningState() {
+	_, addr := newPrefundAccount(g.stateDB)
+
+	state := struct {
+		MiningVelocity    *big.Int
+		NextHalvingSupply *big.Int
+		LastHalvedAmount  *big.Int
+		TotalSupply       *big.Int
+	}{}
+	query := func() {
+		input, err := GovernanceABI.ABI.Pack("miningState")
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		err = GovernanceABI.ABI.Unpack(&state, "miningState", res)
+		g.Require().NoError(err)
+		g.Require().Equal(g.s.MiningVelocity().String(), state.MiningVelocity.String())
+		g.Require().Equal(g.s.NextHalvingSupply().String(), state.NextHalvingSupply.String())
+		g.Require().Equal(g.s.LastHalvedAmount().String(), state.LastHalvedAmount.String())
+		g.Require().Equal(g.s.TotalSupply().String(), state.TotalSupply.String())
+	}
+
+	g.s.IncTotalSupply(big.NewInt(1e18))
+	query()
+	velocity := state.MiningVelocity
+
+	// Values after halving.
+	g.s.MiningHalved()
+	query()
+	g.Require().Equal(new(big.Int).Div(velocity, big.NewInt(2)).String(), state.MiningVelocity.String())
+}
+
+type testCoreMock struct {
+	defaultCoreDKGUtils
+
+	newDKGGPKError error
+	tsigReturn     bool
+}
+
+func (m *testCoreMock) NewGroupPublicKey(*GovernanceState, *big.Int, int) (tsigVerifierIntf, error) {
+	if m.newDKGGPKError != nil {
+		return nil, m.newDKGGPKError
+	}
+	return &testTSigVerifierMock{m.tsigReturn}, nil
+}
+
+type testTSigVerifierMock struct {
+	ret bool
+}
+
+func (v *testTSigVerifierMock) VerifySignature(coreCommon.Hash, coreCrypto.Signature) bool {
+	return v.ret
+}
+
+func (g *OracleContractsTestSuite) TestProposeCRSRefund() {
+	mock := &testCoreMock{}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
+	}
+
+	// Fill the award pool.
+	pool := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1))
+	g.s.IncAwardPool(pool)
+	g.stateDB.AddBalance(GovernanceContractAddress, pool)
+
+	g.context.Round = big.NewInt(0)
+	_, addr := newPrefundAccount(g.stateDB)
+	balance := g.stateDB.GetBalance(addr)
+
+	input, err := GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(1), randomBytes(32, 32))
+	g.Require().NoError(err)
+
+	// Invalid signature is not refunded.
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().Equal(0, g.stateDB.GetBalance(addr).Cmp(balance))
+	g.Require().Equal(0, g.s.AwardPool().Cmp(pool))
+
+	mock.tsigReturn = true
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+
+	refund := new(big.Int).Mul(big.NewInt(GovernanceActionGasCost), g.context.GasPrice)
+	g.Require().Equal(0, g.stateDB.GetBalance(addr).Cmp(new(big.Int).Add(balance, refund)))
+	g.Require().Equal(0, g.s.AwardPool().Cmp(new(big.Int).Sub(pool, refund)))
+}
+
+func (g *OracleContractsTestSuite) TestCRSProposerReward() {
+	mock := &testCoreMock{}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
 	}
 
-	block1.Signature, err = privKey.Sign(hashBlock(block1))
+	// Only owner can set the reward.
+	reward := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(2))
+	input, err := GovernanceABI.ABI.Pack("setCRSProposerReward", reward)
 	g.Require().NoError(err)
-	block2.Signature, err = privKey.Sign(hashBlock(block2))
+	_, addr := newPrefundAccount(g.stateDB)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
 
-	block1Bytes, err := rlp.EncodeToBytes(block1)
+	input, err = GovernanceABI.ABI.Pack("crsProposerReward")
 	g.Require().NoError(err)
-	block2Bytes, err := rlp.EncodeToBytes(block2)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
+	value := new(big.Int)
+	err = GovernanceABI.ABI.Unpack(&value, "crsProposerReward", res)
+	g.Require().NoError(err)
+	g.Require().Equal(reward.String(), value.String())
 
-	// Report wrong type (fork vote)
-	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkVote), block1Bytes, block2Bytes)
+	pool := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+	g.s.IncAwardPool(pool)
+	g.stateDB.AddBalance(GovernanceContractAddress, pool)
+
+	g.context.Round = big.NewInt(0)
+	balance := g.stateDB.GetBalance(addr)
+	input, err = GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(1), randomBytes(32, 32))
 	g.Require().NoError(err)
+
+	// Invalid signature is not rewarded.
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().Error(err)
+	g.Require().Equal(balance.String(), g.stateDB.GetBalance(addr).String())
+	g.Require().Equal(pool.String(), g.s.AwardPool().String())
 
-	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), block1Bytes, block2Bytes)
-	g.Require().NoError(err)
+	mock.tsigReturn = true
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
 
-	node := g.s.Node(big.NewInt(0))
-	g.Require().Equal(node.Fined, g.s.FineValue(big.NewInt(FineTypeForkBlock)))
+	refund := new(big.Int).Mul(big.NewInt(GovernanceActionGasCost), g.context.GasPrice)
+	paid := new(big.Int).Add(refund, reward)
+	g.Require().Equal(new(big.Int).Add(balance, paid).String(), g.stateDB.GetBalance(addr).String())
+	g.Require().Equal(new(big.Int).Sub(pool, paid).String(), g.s.AwardPool().String())
 
-	// Duplicate report should fail.
-	input, err = GovernanceABI.ABI.Pack("report", big.NewInt(FineTypeForkBlock), block1Bytes, block2Bytes)
-	g.Require().NoError(err)
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["CRSProposerRewarded"].Id(), log.Topics[0])
+	g.Require().Equal(addr.Hash(), log.Topics[1])
+	g.Require().Equal(common.BigToHash(big.NewInt(1)), log.Topics[2])
+	g.Require().Equal(common.BigToHash(reward).Bytes(), log.Data)
+
+	// The same round can not be rewarded twice.
 	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().Error(err)
+	g.Require().Equal(new(big.Int).Sub(pool, paid).String(), g.s.AwardPool().String())
+}
 
-	// Check if finedRecords is set.
-	payloads := [][]byte{block1Bytes, block2Bytes}
-	sort.Sort(sortBytes(payloads))
+func (g *OracleContractsTestSuite) TestProposeCRSRevertReason() {
+	mock := &testCoreMock{newDKGGPKError: dkgTypes.ErrNotReachThreshold}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
+	}
 
-	hash := Bytes32(crypto.Keccak256Hash(payloads...))
-	input, err = GovernanceABI.ABI.Pack("finedRecords", hash)
-	g.Require().NoError(err)
-	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
+	g.context.Round = big.NewInt(0)
+	_, addr := newPrefundAccount(g.stateDB)
 
-	var value bool
-	err = GovernanceABI.ABI.Unpack(&value, "finedRecords", res)
+	input, err := GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(1), randomBytes(32, 32))
 	g.Require().NoError(err)
-	g.Require().True(value)
+
+	reason := func() string {
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Equal(errExecutionReverted, err)
+		var reason string
+		err = revertReasonArguments.Unpack(&reason, res[4:])
+		g.Require().NoError(err)
+		return reason
+	}
+
+	// Too few master public keys.
+	g.Require().Equal("threshold not reached", reason())
+
+	// Bad signature.
+	mock.newDKGGPKError = nil
+	g.Require().Equal("invalid signature", reason())
+	g.Require().Equal(uint64(0), g.s.CRSRound().Uint64())
 }
 
-func (g *OracleContractsTestSuite) TestMiscVariableReading() {
-	privKey, addr := newPrefundAccount(g.stateDB)
-	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+func (g *OracleContractsTestSuite) TestDKGStatus() {
+	_, addr := newPrefundAccount(g.stateDB)
 
-	input, err := GovernanceABI.ABI.Pack("totalSupply")
+	g.s.SetDKGRound(big.NewInt(3))
+	for i := 0; i < 2; i++ {
+		g.s.PushDKGMasterPublicKey(randomBytes(64, 64))
+	}
+	g.s.PushDKGComplaint(randomBytes(64, 64))
+	for i := 0; i < 2; i++ {
+		g.s.IncDKGMPKReadysCount()
+	}
+	g.s.IncDKGFinalizedsCount()
+
+	input, err := GovernanceABI.ABI.Pack("dkgStatus")
 	g.Require().NoError(err)
 	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
+	status := struct {
+		DKGRound        *big.Int
+		MPKCount        *big.Int
+		ComplaintCount  *big.Int
+		ReadysCount     *big.Int
+		FinalizedsCount *big.Int
+		DKGSetSize      *big.Int
+	}{}
+	err = GovernanceABI.ABI.Unpack(&status, "dkgStatus", res)
+	g.Require().NoError(err)
+	g.Require().Equal(int64(3), status.DKGRound.Int64())
+	g.Require().Equal(int64(2), status.MPKCount.Int64())
+	g.Require().Equal(int64(1), status.ComplaintCount.Int64())
+	g.Require().Equal(int64(2), status.ReadysCount.Int64())
+	g.Require().Equal(int64(1), status.FinalizedsCount.Int64())
+	g.Require().Equal(g.s.NotarySetSize().String(), status.DKGSetSize.String())
+}
 
-	input, err = GovernanceABI.ABI.Pack("totalStaked")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
+func (g *OracleContractsTestSuite) TestDKGSucceeded() {
+	mock := &testCoreMock{}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
+	}
 
-	// Stake.
-	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
-	input, err = GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei, Taiwan", "https://dexon.org")
-	g.Require().NoError(err)
-	_, err = g.call(GovernanceContractAddress, addr, input, amount)
-	g.Require().NoError(err)
+	_, addr := newPrefundAccount(g.stateDB)
+	g.s.SetDKGRound(big.NewInt(1))
 
-	input, err = GovernanceABI.ABI.Pack("nodes", big.NewInt(0))
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
+	succeeded := func(round int64) (bool, error) {
+		input, err := GovernanceABI.ABI.Pack("dkgSucceeded", big.NewInt(round))
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		if err != nil {
+			return false, err
+		}
+		var value bool
+		err = GovernanceABI.ABI.Unpack(&value, "dkgSucceeded", res)
+		g.Require().NoError(err)
+		return value, nil
+	}
 
-	input, err = GovernanceABI.ABI.Pack("nodesLength")
-	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
-	g.Require().NoError(err)
-	var value *big.Int
-	err = GovernanceABI.ABI.Unpack(&value, "nodesLength", res)
+	// Threshold missed.
+	for _, err := range []error{dkgTypes.ErrNotReachThreshold, dkgTypes.ErrInvalidThreshold} {
+		mock.newDKGGPKError = err
+		value, err := succeeded(1)
+		g.Require().NoError(err)
+		g.Require().False(value)
+	}
+
+	// Unexpected error.
+	mock.newDKGGPKError = errors.New("unexpected")
+	_, err := succeeded(1)
+	g.Require().Error(err)
+
+	// Success.
+	mock.newDKGGPKError = nil
+	value, err := succeeded(1)
 	g.Require().NoError(err)
-	g.Require().Equal(1, int(value.Uint64()))
+	g.Require().True(value)
 
-	input, err = GovernanceABI.ABI.Pack("nodesOffsetByAddress", addr)
+	// DKG of a later round has not started.
+	value, err = succeeded(2)
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().False(value)
+}
+
+func (g *OracleContractsTestSuite) TestClaimDKGReward() {
+	reward := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+	round := big.NewInt(1)
+	g.s.SetDKGRound(round)
+
+	// Only owner can set the reward.
+	input, err := GovernanceABI.ABI.Pack("setDKGReward", reward)
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "nodesOffsetByAddress", res)
+	_, other := newPrefundAccount(g.stateDB)
+	_, err = g.call(GovernanceContractAddress, other, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
 	g.Require().NoError(err)
-	g.Require().Equal(0, int(value.Uint64()))
+	g.Require().Equal(reward.String(), g.s.DKGReward().String())
+	g.Require().Equal(round.String(), g.s.DKGRewardRound().String())
 
-	addr, err = publicKeyToNodeKeyAddress(pk)
-	g.Require().NoError(err)
-	input, err = GovernanceABI.ABI.Pack("nodesOffsetByNodeKeyAddress", addr)
+	pool := new(big.Int).Mul(reward, big.NewInt(3))
+	g.s.IncAwardPool(pool)
+	g.stateDB.AddBalance(GovernanceContractAddress, pool)
+
+	_, addr := newPrefundAccount(g.stateDB)
+	g.s.PutDKGMPKReady(addr, true)
+	g.s.PutDKGFinalized(addr, true)
+
+	// Ready only.
+	_, readyOnly := newPrefundAccount(g.stateDB)
+	g.s.PutDKGMPKReady(readyOnly, true)
+
+	claim := func(caller common.Address, round *big.Int) string {
+		input, err := GovernanceABI.ABI.Pack("claimDKGReward", round)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, caller, input, big.NewInt(0))
+		if err == nil {
+			return ""
+		}
+		var reason string
+		g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+		return reason
+	}
+
+	balance := g.stateDB.GetBalance(addr)
+	g.Require().Equal("", claim(addr, round))
+	g.Require().Equal(new(big.Int).Add(balance, reward).String(), g.stateDB.GetBalance(addr).String())
+	g.Require().Equal(new(big.Int).Sub(pool, reward).String(), g.s.AwardPool().String())
+	g.Require().True(g.s.DKGRewarded(round, addr))
+
+	logs := g.stateDB.Logs()
+	log := logs[len(logs)-1]
+	g.Require().Equal(GovernanceABI.Events["DKGRewardClaimed"].Id(), log.Topics[0])
+	g.Require().Equal(addr.Hash(), log.Topics[1])
+	g.Require().Equal(common.BigToHash(round), log.Topics[2])
+
+	g.Require().Equal("already claimed", claim(addr, round))
+	g.Require().Equal("not participated", claim(readyOnly, round))
+	g.Require().Equal("not participated", claim(other, round))
+	g.Require().Equal("DKG not started", claim(addr, big.NewInt(2)))
+
+	// Rounds before the reward was set can not be claimed.
+	g.Require().Equal("round not rewarded", claim(addr, big.NewInt(0)))
+	g.s.SetDKGRewardRound(big.NewInt(2))
+	g.Require().Equal("round not rewarded", claim(readyOnly, round))
+	g.Require().Equal(new(big.Int).Sub(pool, reward).String(), g.s.AwardPool().String())
+}
+
+func (g *OracleContractsTestSuite) TestParticipationCount() {
+	privKey, addr := newPrefundAccount(g.stateDB)
+	pk := crypto.FromECDSAPub(&privKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	input, err := GovernanceABI.ABI.Pack("register", pk, "Test1", "test1@dexon.org", "Taipei", "https://dexon.org")
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, addr, input, amount)
 	g.Require().NoError(err)
-	err = GovernanceABI.ABI.Unpack(&value, "nodesOffsetByNodeKeyAddress", res)
+	nodeKeyAddr, err := publicKeyToNodeKeyAddress(pk)
 	g.Require().NoError(err)
-	g.Require().Equal(0, int(value.Uint64()))
 
-	input, err = GovernanceABI.ABI.Pack("fineValues", big.NewInt(0))
+	g.context.Round = big.NewInt(0)
+	signer := coreUtils.NewSigner(coreEcdsa.NewPrivateKeyFromECDSA(privKey))
+	round := big.NewInt(1)
+
+	// Keep the node from being fined as fail-stop once the DKG finalizes.
+	nodeID := coreTypes.NewNodeID(coreEcdsa.NewPublicKeyFromECDSA(&privKey.PublicKey))
+	g.s.PutDKGMasterPublicKeyOffset(Bytes32(nodeID.Hash), big.NewInt(0))
+
+	finalize := func(reset uint64) {
+		final := &dkgTypes.Finalize{Round: round.Uint64(), Reset: reset}
+		g.Require().NoError(signer.SignDKGFinalize(final))
+		b, err := rlp.EncodeToBytes(final)
+		g.Require().NoError(err)
+		input, err := GovernanceABI.ABI.Pack("addDKGFinalize", b)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, nodeKeyAddr, input, big.NewInt(0))
+		g.Require().NoError(err)
+	}
+	participationCount := func() int64 {
+		input, err := GovernanceABI.ABI.Pack("participationCount", nodeKeyAddr)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		count := new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&count, "participationCount", res)
+		g.Require().NoError(err)
+		return count.Int64()
+	}
+
+	// Finalizing is not counted before the upgrade.
+	g.downgrade()
+	finalize(0)
+	g.Require().Equal(int64(0), participationCount())
+	g.s.Upgrade()
+	g.s.PutDKGFinalized(nodeKeyAddr, false)
+	g.s.ResetDKGFinalizedsCount()
+
+	finalize(0)
+	g.Require().Equal(int64(1), participationCount())
+
+	// Finalizing again is not counted.
+	finalize(0)
+	g.Require().Equal(int64(1), participationCount())
+
+	// Nor is finalizing the same round after a DKG reset.
+	g.s.IncDKGResetCount(round)
+	g.s.PutDKGFinalized(nodeKeyAddr, false)
+	g.s.ResetDKGFinalizedsCount()
+	finalize(1)
+	g.Require().True(g.s.DKGFinalized(nodeKeyAddr))
+	g.Require().Equal(int64(1), participationCount())
+
+	// A later round is counted.
+	g.s.IncParticipationCount(nodeKeyAddr, big.NewInt(2))
+	g.Require().Equal(int64(2), participationCount())
+
+	// Round 0 is counted once like any other round.
+	other := common.Address{1}
+	g.Require().Equal(int64(-1), g.s.LastParticipatedRound(other).Int64())
+	g.s.IncParticipationCount(other, big.NewInt(0))
+	g.s.IncParticipationCount(other, big.NewInt(0))
+	g.Require().Equal(int64(1), g.s.ParticipationCount(other).Int64())
+	g.Require().Equal(int64(0), g.s.LastParticipatedRound(other).Int64())
+}
+
+func (g *OracleContractsTestSuite) TestProposeCRSReplay() {
+	mock := &testCoreMock{tsigReturn: true}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
+	}
+
+	g.context.Round = big.NewInt(0)
+	_, addr := newPrefundAccount(g.stateDB)
+
+	signedCRS := randomBytes(32, 32)
+	input, err := GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(1), signedCRS)
 	g.Require().NoError(err)
-	res, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 	g.Require().NoError(err)
-}
+	crs := crypto.Keccak256Hash(signedCRS)
+	g.Require().Equal(crs, g.s.CRS())
+	g.Require().Equal(int64(1), g.s.CRSRound().Int64())
 
-func (g *OracleContractsTestSuite) TestHalvingCondition() {
-	// TotalSupply 2.5B reached
-	g.s.MiningHalved()
-	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(3.25e9)).String(),
-		g.s.NextHalvingSupply().String())
-	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(0.75e9)).String(),
-		g.s.LastHalvedAmount().String())
+	// Double proposal of the same round should fail.
+	input, err = GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(1), randomBytes(32, 32))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	g.Require().Equal(crs, g.s.CRS())
 
-	// TotalSupply 3.25B reached
-	g.s.MiningHalved()
-	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(3.625e9)).String(),
-		g.s.NextHalvingSupply().String())
-	g.Require().Equal(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(0.375e9)).String(),
-		g.s.LastHalvedAmount().String())
+	// Proposal of a round other than the next one should fail.
+	for _, round := range []int64{0, 2} {
+		input, err = GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(round), randomBytes(32, 32))
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Error(err)
+		g.Require().Equal(crs, g.s.CRS())
+		g.Require().Equal(int64(1), g.s.CRSRound().Int64())
+	}
 }
 
-type testCoreMock struct {
-	newDKGGPKError error
-	tsigReturn     bool
-}
+func (g *OracleContractsTestSuite) TestDKGResetGracePercent() {
+	_, addr := newPrefundAccount(g.stateDB)
 
-func (m *testCoreMock) NewGroupPublicKey(*GovernanceState, *big.Int, int) (tsigVerifierIntf, error) {
-	if m.newDKGGPKError != nil {
-		return nil, m.newDKGGPKError
+	query := func() int64 {
+		input, err := GovernanceABI.ABI.Pack("dkgResetGracePercent")
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().NoError(err)
+		value := new(big.Int)
+		err = GovernanceABI.ABI.Unpack(&value, "dkgResetGracePercent", res)
+		g.Require().NoError(err)
+		return value.Int64()
 	}
-	return &testTSigVerifierMock{m.tsigReturn}, nil
-}
+	g.Require().Equal(int64(85), query())
 
-type testTSigVerifierMock struct {
-	ret bool
-}
+	// Only owner can set, within (0, 100].
+	input, err := GovernanceABI.ABI.Pack("setDKGResetGracePercent", big.NewInt(70))
+	g.Require().NoError(err)
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Error(err)
+	_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(int64(70), query())
 
-func (v *testTSigVerifierMock) VerifySignature(coreCommon.Hash, coreCrypto.Signature) bool {
-	return v.ret
+	for _, percent := range []int64{0, 101} {
+		input, err = GovernanceABI.ABI.Pack("setDKGResetGracePercent", big.NewInt(percent))
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		g.Require().Error(err)
+	}
+	g.Require().Equal(int64(70), query())
 }
 
 func (g *OracleContractsTestSuite) TestResetDKG() {
@@ -1133,6 +5013,9 @@ func (g *OracleContractsTestSuite) TestResetDKG() {
 		}
 	}
 
+	// Genesis starts with the DKG of DKGDelayRound, see Initialize.
+	g.s.SetDKGRound(big.NewInt(int64(dexCore.DKGDelayRound)))
+
 	// Fill data for previous rounds.
 	roundHeight := int64(g.config.RoundLength)
 	round := int(dexCore.DKGDelayRound) + 3
@@ -1164,6 +5047,19 @@ func (g *OracleContractsTestSuite) TestResetDKG() {
 		newCRS := randomBytes(common.HashLength, common.HashLength)
 		input, err := GovernanceABI.ABI.Pack("resetDKG", newCRS)
 		g.Require().NoError(err)
+
+		// A larger grace window moves the reset gate.
+		setGrace := func(percent int64) {
+			input, err := GovernanceABI.ABI.Pack("setDKGResetGracePercent", big.NewInt(percent))
+			g.Require().NoError(err)
+			_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+			g.Require().NoError(err)
+		}
+		setGrace(90)
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Error(err)
+		setGrace(85)
+
 		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
 		g.Require().NoError(err)
 
@@ -1171,6 +5067,13 @@ func (g *OracleContractsTestSuite) TestResetDKG() {
 		newCRSHash := crypto.Keccak256Hash(newCRS)
 		g.Require().Equal(newCRSHash, g.s.CRS())
 
+		// CRS set by reset can not be overwritten by proposeCRS.
+		input, err = GovernanceABI.ABI.Pack("proposeCRS", roundPlusOne, randomBytes(32, 32))
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		g.Require().Error(err)
+		g.Require().Equal(newCRSHash, g.s.CRS())
+
 		// Test if MPK is purged.
 		g.Require().Len(g.s.DKGMasterPublicKeys(), 0)
 		// Test if MPKReady is purged.
@@ -1192,11 +5095,152 @@ func (g *OracleContractsTestSuite) TestResetDKG() {
 		}
 
 		g.Require().Equal(int64(r+1), g.s.DKGResetCount(roundPlusOne).Int64())
+		g.Require().Equal(roundPlusOne, g.s.CRSRound())
+		g.Require().Equal(roundPlusOne, g.s.DKGRound())
 
 		addDKG(round+1, false, false)
 	}
+
+	// The next round proceeds normally after resets.
+	round++
+	g.s.PushRoundHeight(big.NewInt(int64(round+repeat) * roundHeight))
+	g.context.Round = big.NewInt(int64(round))
+	addDKG(round+1, true, true)
+	g.Require().Equal(int64(round+1), g.s.CRSRound().Int64())
+	g.Require().Equal(int64(round+1), g.s.DKGRound().Int64())
+}
+
+func (g *OracleContractsTestSuite) TestRoundConsistency() {
+	mock := &testCoreMock{tsigReturn: true}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
+	}
+
+	g.context.Round = big.NewInt(0)
+	_, addr := newPrefundAccount(g.stateDB)
+
+	// DKG running ahead of the proposed CRS is rejected.
+	g.s.SetDKGRound(big.NewInt(2))
+	input, err := GovernanceABI.ABI.Pack("proposeCRS", big.NewInt(1), randomBytes(32, 32))
+	g.Require().NoError(err)
+	res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().Equal(errExecutionReverted, err)
+	var reason string
+	g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+	g.Require().Equal(errRoundInconsistent.Error(), reason)
+	g.Require().Equal(uint64(0), g.s.CRSRound().Uint64())
+
+	g.s.SetDKGRound(big.NewInt(1))
+	_, err = g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+	g.Require().NoError(err)
+	g.Require().Equal(uint64(1), g.s.CRSRound().Uint64())
+}
+
+func (g *OracleContractsTestSuite) TestResetThenProposeCRSRoundConsistency() {
+	mock := &testCoreMock{tsigReturn: true}
+	OracleContracts[GovernanceContractAddress] = func() OracleContract {
+		return &GovernanceContract{
+			coreDKGUtils: mock,
+		}
+	}
+
+	roundHeight := int64(g.config.RoundLength)
+	round := int64(dexCore.DKGDelayRound) + 1
+	for i := int64(1); i <= round; i++ {
+		g.s.PushRoundHeight(big.NewInt(i * roundHeight))
+	}
+	g.context.Round = big.NewInt(round)
+	g.context.BlockNumber = big.NewInt(round*roundHeight + roundHeight*85/100)
+	_, addr := newPrefundAccount(g.stateDB)
+
+	call := func(method string, args ...interface{}) error {
+		input, err := GovernanceABI.ABI.Pack(method, args...)
+		g.Require().NoError(err)
+		res, err := g.call(GovernanceContractAddress, addr, input, big.NewInt(0))
+		if err != nil && len(res) > 4 {
+			var reason string
+			g.Require().NoError(revertReasonArguments.Unpack(&reason, res[4:]))
+			return errors.New(reason)
+		}
+		return err
+	}
+	setCheck := func(enabled bool) {
+		input, err := GovernanceABI.ABI.Pack("setRoundConsistencyCheck", enabled)
+		g.Require().NoError(err)
+		_, err = g.call(GovernanceContractAddress, g.config.Owner, input, big.NewInt(0))
+		g.Require().NoError(err)
+	}
+
+	// A reset restarts the DKG of the round it sets the CRS for, even if the
+	// DKG of that round never started.
+	g.s.SetDKGRound(big.NewInt(round - 1))
+	g.Require().NoError(call("resetDKG", randomBytes(32, 32)))
+	g.Require().Equal(round+1, g.s.CRSRound().Int64())
+	g.Require().Equal(round+1, g.s.DKGRound().Int64())
+
+	// The next round proposes its CRS from the DKG restarted by the reset.
+	round++
+	g.s.PushRoundHeight(big.NewInt(round * roundHeight))
+	g.context.Round = big.NewInt(round)
+	g.Require().NoError(call("proposeCRS", big.NewInt(round+1), randomBytes(32, 32)))
+	g.Require().Equal(round+1, g.s.CRSRound().Int64())
+	g.Require().Equal(round, g.s.DKGRound().Int64())
+
+	// Without a DKG for that round, proposing again a round later drifts.
+	round++
+	g.s.PushRoundHeight(big.NewInt(round * roundHeight))
+	g.context.Round = big.NewInt(round)
+	err := call("proposeCRS", big.NewInt(round+1), randomBytes(32, 32))
+	g.Require().EqualError(err, errRoundInconsistent.Error())
+	g.Require().Equal(round, g.s.CRSRound().Int64())
+
+	// The check can be disabled.
+	setCheck(false)
+	g.Require().NoError(call("proposeCRS", big.NewInt(round+1), randomBytes(32, 32)))
+	g.Require().Equal(round+1, g.s.CRSRound().Int64())
 }
 
 func TestOracleContracts(t *testing.T) {
 	suite.Run(t, new(OracleContractsTestSuite))
 }
+
+func BenchmarkDKGMasterPublicKeyDecoding(b *testing.B) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	gs := &GovernanceState{statedb}
+
+	setSize := uint32(31)
+	threshold := coreUtils.GetDKGThreshold(&coreTypes.Config{NotarySetSize: setSize})
+	for i := uint32(0); i < setSize; i++ {
+		_, pubShares := cryptoDKG.NewPrivateKeyShares(threshold)
+		mpk := &dkgTypes.MasterPublicKey{
+			DKGID:           dkgTypes.NewID(coreTypes.NodeID{Hash: coreCommon.NewRandomHash()}),
+			PublicKeyShares: *pubShares.Move(),
+		}
+		data, err := rlp.EncodeToBytes(mpk)
+		if err != nil {
+			b.Fatal(err)
+		}
+		gs.PushDKGMasterPublicKey(data)
+	}
+
+	// resetDKG reads all master public keys for the group public key and again
+	// when clearing their offsets.
+	b.Run("Uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			gs.DKGMasterPublicKeyItems()
+			gs.DKGMasterPublicKeyItems()
+		}
+	})
+	b.Run("Cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c := &defaultCoreDKGUtils{}
+			c.DKGMasterPublicKeyItems(gs)
+			c.DKGMasterPublicKeyItems(gs)
+		}
+	})
+}